@@ -0,0 +1,43 @@
+package main
+
+import (
+	"github.com/veryfi/veryfi-go/v4/veryfi"
+)
+
+// loadOptions resolves the client options. Flags take precedence over
+// environment variables, which take precedence over the config file; see
+// veryfi.LoadOptions.
+func loadOptions(g globalFlags, getenv func(string) string) (*veryfi.Options, error) {
+	opts, err := veryfi.LoadOptions(veryfi.LoadConfig{
+		File:    g.config,
+		Profile: g.profile,
+		Getenv:  getenv,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, f := range []struct {
+		dst   *string
+		value string
+	}{
+		{&opts.EnvironmentURL, g.environmentURL},
+		{&opts.ClientID, g.clientID},
+		{&opts.ClientSecret, g.clientSecret},
+		{&opts.Username, g.username},
+		{&opts.APIKey, g.apiKey},
+	} {
+		if f.value != "" {
+			*f.dst = f.value
+		}
+	}
+	if g.timeout != 0 {
+		opts.HTTP.Timeout = g.timeout
+	}
+
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+
+	return opts, nil
+}
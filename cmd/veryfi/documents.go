@@ -0,0 +1,202 @@
+package main
+
+import (
+	"flag"
+	"strconv"
+	"strings"
+
+	"github.com/veryfi/veryfi-go/v4/veryfi/scheme"
+)
+
+// runProcess processes a document from a local file or a URL.
+func runProcess(e *env, args []string) error {
+	fs := newFlagSet(e, "process", "[flags] <file-or-url>")
+	detailed := fs.Bool("detailed", false, "return confidence scores and bounding boxes")
+	shared := scheme.DocumentSharedOptions{}
+	registerSharedFlags(fs, &shared)
+
+	args, err := parseArgs(fs, args, 1)
+	if err != nil {
+		return err
+	}
+	api, err := e.client()
+	if err != nil {
+		return err
+	}
+	source := args[0]
+
+	if strings.HasPrefix(source, "http://") || strings.HasPrefix(source, "https://") {
+		opts := scheme.DocumentURLOptions{FileURL: source, DocumentSharedOptions: shared}
+		if *detailed {
+			return result(e)(api.ProcessDetailedDocumentURL(opts))
+		}
+		return result(e)(api.ProcessDocumentURL(opts))
+	}
+
+	opts := scheme.DocumentUploadOptions{FilePath: source, DocumentSharedOptions: shared}
+	if *detailed {
+		return result(e)(api.ProcessDetailedDocumentUpload(opts))
+	}
+	return result(e)(api.ProcessDocumentUpload(opts))
+}
+
+// runGet gets a document.
+func runGet(e *env, args []string) error {
+	fs := newFlagSet(e, "get", "[flags] <document-id>")
+	detailed := fs.Bool("detailed", false, "return confidence scores and bounding boxes")
+	auditTrail := fs.Bool("audit-trail", false, "return the audit trail")
+
+	args, err := parseArgs(fs, args, 1)
+	if err != nil {
+		return err
+	}
+	api, err := e.client()
+	if err != nil {
+		return err
+	}
+
+	opts := scheme.DocumentGetOptions{}
+	if *auditTrail {
+		opts.ReturnAuditTrail = "1"
+	}
+	if *detailed {
+		return result(e)(api.GetDetailedDocument(args[0], opts))
+	}
+	return result(e)(api.GetDocument(args[0], opts))
+}
+
+// runSearch searches documents.
+func runSearch(e *env, args []string) error {
+	fs := newFlagSet(e, "search", "[flags]")
+	detailed := fs.Bool("detailed", false, "return confidence scores and bounding boxes")
+	opts := scheme.DocumentSearchOptions{}
+	status := ""
+	for _, f := range []struct {
+		name  string
+		value *string
+		usage string
+	}{
+		{"q", &opts.Q, "search query"},
+		{"external-id", &opts.ExternalID, "external ID"},
+		{"tag", &opts.Tag, "tag name"},
+		{"created-gt", &opts.CreatedGT, "created after (YYYY-MM-DD+HH:MM:SS)"},
+		{"created-gte", &opts.CreatedGTE, "created on or after"},
+		{"created-lt", &opts.CreatedLT, "created before"},
+		{"created-lte", &opts.CreatedLTE, "created on or before"},
+		{"status", &status, "status: processed, reviewed or archived"},
+		{"device-id", &opts.DeviceID, "device ID"},
+		{"owner", &opts.Owner, "owner username"},
+		{"updated-gt", &opts.UpdatedGT, "updated after"},
+		{"updated-gte", &opts.UpdatedGTE, "updated on or after"},
+		{"updated-lt", &opts.UpdatedLT, "updated before"},
+		{"updated-lte", &opts.UpdatedLTE, "updated on or before"},
+		{"date-gt", &opts.DateGT, "document date after"},
+		{"date-gte", &opts.DateGTE, "document date on or after"},
+		{"date-lt", &opts.DateLT, "document date before"},
+		{"date-lte", &opts.DateLTE, "document date on or before"},
+		{"page", &opts.Page, "page number"},
+		{"page-size", &opts.PageSize, "documents per page"},
+		{"track-total-results", &opts.TrackTotalResults, "count all matching documents (true/false)"},
+	} {
+		fs.StringVar(f.value, f.name, "", f.usage)
+	}
+
+	if _, err := parseArgs(fs, args, 0); err != nil {
+		return err
+	}
+	api, err := e.client()
+	if err != nil {
+		return err
+	}
+	opts.Status = scheme.DocumentStatus(status)
+
+	if *detailed {
+		return result(e)(api.SearchDetailedDocuments(opts))
+	}
+	return result(e)(api.SearchDocuments(opts))
+}
+
+// runUpdate updates a document.
+func runUpdate(e *env, args []string) error {
+	fs := newFlagSet(e, "update", "[flags] <document-id>")
+	opts := scheme.DocumentUpdateOptions{}
+	status := ""
+	var subtotal, tax, tip, total optionalAmount
+	fs.StringVar(&opts.BillToName, "bill-to-name", "", "bill-to name")
+	fs.StringVar(&opts.BillToAddress, "bill-to-address", "", "bill-to address")
+	fs.StringVar(&opts.Category, "category", "", "category")
+	fs.StringVar(&opts.Date, "date", "", "document date (YYYY-MM-DD HH:MM:SS)")
+	fs.StringVar(&opts.DueDate, "due-date", "", "due date (YYYY-MM-DD)")
+	fs.StringVar(&opts.InvoiceNumber, "invoice-number", "", "invoice number")
+	fs.Var(&subtotal, "subtotal", "subtotal")
+	fs.Var(&tax, "tax", "tax")
+	fs.Var(&tip, "tip", "tip")
+	fs.Var(&total, "total", "total")
+	fs.StringVar(&opts.Vendor.Name, "vendor-name", "", "vendor name")
+	fs.StringVar(&opts.Vendor.Address, "vendor-address", "", "vendor address")
+	fs.StringVar(&opts.ExternalID, "external-id", "", "external ID")
+	fs.StringVar(&status, "status", "", "status: processed, reviewed or archived")
+
+	args, err := parseArgs(fs, args, 1)
+	if err != nil {
+		return err
+	}
+	api, err := e.client()
+	if err != nil {
+		return err
+	}
+	opts.Status = scheme.DocumentStatus(status)
+	opts.Subtotal, opts.Tax, opts.Tip, opts.Total = subtotal.value, tax.value, tip.value, total.value
+
+	return result(e)(api.UpdateDocument(args[0], opts))
+}
+
+// runDelete deletes a document.
+func runDelete(e *env, args []string) error {
+	fs := newFlagSet(e, "delete", "<document-id>")
+	args, err := parseArgs(fs, args, 1)
+	if err != nil {
+		return err
+	}
+	api, err := e.client()
+	if err != nil {
+		return err
+	}
+
+	if err := api.DeleteDocument(args[0]); err != nil {
+		return err
+	}
+	return render(e.out, e.format, deleted{Kind: "document", ID: args[0], Deleted: true})
+}
+
+// registerSharedFlags binds the processing options shared by uploads and URLs.
+func registerSharedFlags(fs *flag.FlagSet, opts *scheme.DocumentSharedOptions) {
+	fs.StringVar(&opts.FileName, "file-name", "", "file name to store the document under")
+	fs.Var((*listFlag)(&opts.Categories), "categories", "comma-separated categories to choose from")
+	fs.Var((*listFlag)(&opts.Tags), "tags", "comma-separated tags to add")
+	fs.Func("max-pages", "maximum number of pages to process", func(s string) error {
+		n, err := strconv.Atoi(s)
+		if err != nil {
+			return err
+		}
+		opts.MaxPagesToProcess = &n
+		return nil
+	})
+	fs.BoolVar(&opts.BoostMode, "boost", false, "skip data enrichment for faster processing")
+	fs.BoolVar(&opts.AutoDelete, "auto-delete", false, "delete the document once processed")
+	fs.BoolVar(&opts.DetectBlur, "detect-blur", false, "detect blurry images")
+	fs.BoolVar(&opts.ParseAddress, "parse-address", false, "parse addresses into components")
+	fs.StringVar(&opts.ExternalID, "external-id", "", "external ID to attach")
+	fs.BoolVar(&opts.Async, "async", false, "process asynchronously")
+}
+
+// result returns a function that renders a command's result, or returns the
+// error of the call that produced it.
+func result(e *env) func(v interface{}, err error) error {
+	return func(v interface{}, err error) error {
+		if err != nil {
+			return err
+		}
+		return render(e.out, e.format, v)
+	}
+}
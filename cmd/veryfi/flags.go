@@ -0,0 +1,94 @@
+package main
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/veryfi/veryfi-go/v4/veryfi/scheme"
+)
+
+// optionalString is a string flag that stays nil unless it is given.
+type optionalString struct {
+	value *string
+}
+
+// String implements flag.Value.
+func (o *optionalString) String() string {
+	if o == nil || o.value == nil {
+		return ""
+	}
+	return *o.value
+}
+
+// Set implements flag.Value.
+func (o *optionalString) Set(s string) error {
+	o.value = &s
+	return nil
+}
+
+// optionalFloat is a float64 flag that stays nil unless it is given.
+type optionalFloat struct {
+	value *float64
+}
+
+// String implements flag.Value.
+func (o *optionalFloat) String() string {
+	if o == nil || o.value == nil {
+		return ""
+	}
+	return formatFloat(*o.value)
+}
+
+// Set implements flag.Value.
+func (o *optionalFloat) Set(s string) error {
+	v, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return err
+	}
+	o.value = &v
+	return nil
+}
+
+// optionalAmount is a decimal amount flag that stays nil unless it is given.
+type optionalAmount struct {
+	value *scheme.Amount
+}
+
+// String implements flag.Value.
+func (o *optionalAmount) String() string {
+	if o == nil || o.value == nil {
+		return ""
+	}
+	return o.value.String()
+}
+
+// Set implements flag.Value.
+func (o *optionalAmount) Set(s string) error {
+	v, err := scheme.ParseAmount(s)
+	if err != nil {
+		return err
+	}
+	o.value = &v
+	return nil
+}
+
+// listFlag is a comma-separated list flag that may also be repeated.
+type listFlag []string
+
+// String implements flag.Value.
+func (l *listFlag) String() string {
+	if l == nil {
+		return ""
+	}
+	return strings.Join(*l, ",")
+}
+
+// Set implements flag.Value.
+func (l *listFlag) Set(s string) error {
+	for _, v := range strings.Split(s, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			*l = append(*l, v)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,122 @@
+package main
+
+import (
+	"flag"
+
+	"github.com/pkg/errors"
+
+	"github.com/veryfi/veryfi-go/v4/veryfi/scheme"
+)
+
+// runLineItems dispatches the line-items subcommands.
+func runLineItems(e *env, args []string) error {
+	const usage = "list|get|add|update|delete [flags] <document-id> [line-item-id]"
+	if len(args) == 0 {
+		return errors.Errorf("usage: veryfi line-items %s", usage)
+	}
+
+	action, args := args[0], args[1:]
+	fs := newFlagSet(e, "line-items "+action, "[flags] <document-id> [line-item-id]")
+	switch action {
+	case "list":
+		args, err := parseArgs(fs, args, 1)
+		if err != nil {
+			return err
+		}
+		api, err := e.client()
+		if err != nil {
+			return err
+		}
+		return result(e)(api.GetLineItems(args[0]))
+	case "get":
+		args, err := parseArgs(fs, args, 2)
+		if err != nil {
+			return err
+		}
+		api, err := e.client()
+		if err != nil {
+			return err
+		}
+		return result(e)(api.GetLineItem(args[0], args[1]))
+	case "add":
+		opts := registerLineItemFlags(fs)
+		args, err := parseArgs(fs, args, 1)
+		if err != nil {
+			return err
+		}
+		api, err := e.client()
+		if err != nil {
+			return err
+		}
+		return result(e)(api.AddLineItem(args[0], opts.build()))
+	case "update":
+		opts := registerLineItemFlags(fs)
+		args, err := parseArgs(fs, args, 2)
+		if err != nil {
+			return err
+		}
+		api, err := e.client()
+		if err != nil {
+			return err
+		}
+		return result(e)(api.UpdateLineItem(args[0], args[1], opts.build()))
+	case "delete":
+		args, err := parseArgs(fs, args, 2)
+		if err != nil {
+			return err
+		}
+		api, err := e.client()
+		if err != nil {
+			return err
+		}
+		if err := api.DeleteLineItem(args[0], args[1]); err != nil {
+			return err
+		}
+		return render(e.out, e.format, deleted{Kind: "line_item", ID: args[1], Deleted: true})
+	}
+
+	return errors.Errorf("unknown action %q, usage: veryfi line-items %s", action, usage)
+}
+
+// lineItemFlags holds the flags of a line item to add or update.
+type lineItemFlags struct {
+	order         int
+	sku           optionalString
+	description   optionalString
+	category      optionalString
+	total         optionalAmount
+	tax           optionalAmount
+	price         optionalAmount
+	unitOfMeasure optionalString
+	quantity      optionalFloat
+}
+
+// registerLineItemFlags binds the line item flags to fs.
+func registerLineItemFlags(fs *flag.FlagSet) *lineItemFlags {
+	f := &lineItemFlags{}
+	fs.IntVar(&f.order, "order", 0, "position of the line item")
+	fs.Var(&f.sku, "sku", "SKU")
+	fs.Var(&f.description, "description", "description")
+	fs.Var(&f.category, "category", "category")
+	fs.Var(&f.total, "total", "total")
+	fs.Var(&f.tax, "tax", "tax")
+	fs.Var(&f.price, "price", "unit price")
+	fs.Var(&f.unitOfMeasure, "unit-of-measure", "unit of measure")
+	fs.Var(&f.quantity, "quantity", "quantity")
+	return f
+}
+
+// build returns the line item options with only the given flags set.
+func (f *lineItemFlags) build() scheme.LineItemOptions {
+	return scheme.LineItemOptions{
+		Order:         f.order,
+		SKU:           f.sku.value,
+		Description:   f.description.value,
+		Category:      f.category.value,
+		Total:         f.total.value,
+		Tax:           f.tax.value,
+		Price:         f.price.value,
+		UnitOfMeasure: f.unitOfMeasure.value,
+		Quantity:      f.quantity.value,
+	}
+}
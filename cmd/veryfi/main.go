@@ -0,0 +1,214 @@
+// Command veryfi is a command-line interface to the Veryfi API.
+//
+// Usage:
+//
+//	veryfi [global flags] <command> [flags] [arguments]
+//
+// Run `veryfi -h` for the list of commands and `veryfi <command> -h` for the
+// flags of a command.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/veryfi/veryfi-go/v4/veryfi"
+)
+
+// env is the state shared by every command.
+type env struct {
+	// connect creates the client that commands call.
+	connect func() (veryfi.API, error)
+
+	// api caches the client created by connect.
+	api veryfi.API
+
+	// out receives the rendered results.
+	out io.Writer
+
+	// errOut receives usage and flag errors.
+	errOut io.Writer
+
+	// format is the output format: json, table or csv.
+	format string
+}
+
+// command describes a subcommand.
+type command struct {
+	// summary is a one-line description shown in the usage.
+	summary string
+
+	// run executes the command with its own arguments.
+	run func(e *env, args []string) error
+}
+
+// commands holds every subcommand by name.
+var commands = map[string]command{
+	"process":    {"Process a document from a file or URL", runProcess},
+	"get":        {"Get a document", runGet},
+	"search":     {"Search documents", runSearch},
+	"update":     {"Update a document", runUpdate},
+	"delete":     {"Delete a document", runDelete},
+	"line-items": {"List, get, add, update or delete line items", runLineItems},
+	"tags":       {"List, add or delete document and global tags", runTags},
+	"watch":      {"Upload files dropped into a directory", runWatch},
+}
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr, os.Getenv, newClient))
+}
+
+// newClient returns a Veryfi client for v8 API.
+func newClient(opts *veryfi.Options) (veryfi.API, error) {
+	return veryfi.NewClientV8(opts)
+}
+
+// run executes the CLI and returns its exit code.
+func run(
+	args []string,
+	stdout, stderr io.Writer,
+	getenv func(string) string,
+	newClient func(*veryfi.Options) (veryfi.API, error),
+) int {
+	g := globalFlags{}
+	fs := flag.NewFlagSet("veryfi", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	g.register(fs)
+	fs.Usage = func() { usage(fs) }
+
+	if err := fs.Parse(args); err != nil {
+		if err == flag.ErrHelp {
+			return 0
+		}
+		return 2
+	}
+	if fs.NArg() == 0 {
+		fs.Usage()
+		return 2
+	}
+
+	name := fs.Arg(0)
+	cmd, ok := commands[name]
+	if !ok {
+		fmt.Fprintf(stderr, "veryfi: unknown command %q\n", name)
+		fs.Usage()
+		return 2
+	}
+
+	switch g.output {
+	case formatJSON, formatTable, formatCSV:
+	default:
+		fmt.Fprintf(stderr, "veryfi: unknown output format %q\n", g.output)
+		return 2
+	}
+
+	// The client is created once the command has parsed its flags, so that
+	// help and flag errors do not require credentials.
+	connect := func() (veryfi.API, error) {
+		opts, err := loadOptions(g, getenv)
+		if err != nil {
+			return nil, err
+		}
+		return newClient(opts)
+	}
+
+	e := &env{connect: connect, out: stdout, errOut: stderr, format: g.output}
+	if err := cmd.run(e, fs.Args()[1:]); err != nil {
+		if err == flag.ErrHelp {
+			return 0
+		}
+		fmt.Fprintf(stderr, "veryfi %s: %v\n", name, err)
+		return 1
+	}
+
+	return 0
+}
+
+// globalFlags holds the flags accepted before the command name.
+type globalFlags struct {
+	clientID       string
+	clientSecret   string
+	username       string
+	apiKey         string
+	environmentURL string
+	profile        string
+	config         string
+	output         string
+	timeout        time.Duration
+}
+
+// register binds the global flags to fs.
+func (g *globalFlags) register(fs *flag.FlagSet) {
+	fs.StringVar(&g.clientID, "client-id", "", "Veryfi client ID (env "+veryfi.EnvClientID+")")
+	fs.StringVar(&g.clientSecret, "client-secret", "", "Veryfi client secret (env "+veryfi.EnvClientSecret+")")
+	fs.StringVar(&g.username, "username", "", "Veryfi username (env "+veryfi.EnvUsername+")")
+	fs.StringVar(&g.apiKey, "api-key", "", "Veryfi API key (env "+veryfi.EnvAPIKey+")")
+	fs.StringVar(&g.environmentURL, "environment-url", "", "Veryfi API host (env "+veryfi.EnvEnvironmentURL+")")
+	fs.StringVar(&g.profile, "profile", "", "profile to read from the config file (env "+veryfi.EnvProfile+`, default "default")`)
+	fs.StringVar(&g.config, "config", "", "path of the YAML or JSON config file (env "+veryfi.EnvConfigFile+", default ~/.veryfi/config.yaml)")
+	fs.StringVar(&g.output, "o", formatJSON, "output format: json, table or csv")
+	fs.DurationVar(&g.timeout, "timeout", 0, "time limit for each HTTP request (default 120s)")
+}
+
+// usage prints the global usage and the list of commands.
+func usage(fs *flag.FlagSet) {
+	w := fs.Output()
+	fmt.Fprintf(w, "Usage: veryfi [global flags] <command> [flags] [arguments]\n\nCommands:\n")
+
+	names := make([]string, 0, len(commands))
+	for name := range commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Fprintf(w, "  %-12s %s\n", name, commands[name].summary)
+	}
+
+	fmt.Fprintf(w, "\nGlobal flags:\n")
+	fs.PrintDefaults()
+}
+
+// client returns the client that commands call, creating it on first use.
+func (e *env) client() (veryfi.API, error) {
+	if e.api == nil {
+		api, err := e.connect()
+		if err != nil {
+			return nil, err
+		}
+		e.api = api
+	}
+
+	return e.api, nil
+}
+
+// newFlagSet returns a flag set for a command that reports errors instead of
+// exiting.
+func newFlagSet(e *env, name string, usage string) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.SetOutput(e.errOut)
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "Usage: veryfi %s %s\n", name, usage)
+		fs.PrintDefaults()
+	}
+	return fs
+}
+
+// parseArgs parses a command's flags and checks the number of positional
+// arguments.
+func parseArgs(fs *flag.FlagSet, args []string, n int) ([]string, error) {
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+	if fs.NArg() != n {
+		fs.Usage()
+		return nil, errors.Errorf("expected %d argument(s), got %d", n, fs.NArg())
+	}
+
+	return fs.Args(), nil
+}
@@ -0,0 +1,164 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/veryfi/veryfi-go/v4/veryfi"
+	"github.com/veryfi/veryfi-go/v4/veryfi/mock"
+	"github.com/veryfi/veryfi-go/v4/veryfi/scheme"
+)
+
+// runCLI runs the CLI against a mock client with the given environment.
+func runCLI(t *testing.T, m *mock.Client, environ map[string]string, args ...string) (int, string, string, *veryfi.Options) {
+	stdout, stderr := &bytes.Buffer{}, &bytes.Buffer{}
+	var opts *veryfi.Options
+	getenv := func(k string) string { return environ[k] }
+	newClient := func(o *veryfi.Options) (veryfi.API, error) {
+		opts = o
+		return m, nil
+	}
+
+	code := run(args, stdout, stderr, getenv, newClient)
+	return code, stdout.String(), stderr.String(), opts
+}
+
+// credentials is an environment with the minimum credentials set.
+var credentials = map[string]string{
+	veryfi.EnvClientID: "envClientID",
+	veryfi.EnvAPIKey:   "vrfk_env",
+}
+
+func TestUnitCLI_ProcessRoutesBySource(t *testing.T) {
+	m := &mock.Client{
+		ProcessDocumentURLFunc: func(opts scheme.DocumentURLOptions) (*scheme.Document, error) {
+			return &scheme.Document{ID: 1}, nil
+		},
+		ProcessDetailedDocumentUploadFunc: func(opts scheme.DocumentUploadOptions) (*scheme.DetailedDocument, error) {
+			return &scheme.DetailedDocument{ID: 2}, nil
+		},
+	}
+
+	code, _, stderr, _ := runCLI(t, m, credentials, "process", "-tags", "a,b", "https://example.com/receipt.jpg")
+	assert.Equal(t, 0, code, stderr)
+	code, _, stderr, _ = runCLI(t, m, credentials, "process", "-detailed", "-max-pages", "2", "receipt.jpg")
+	assert.Equal(t, 0, code, stderr)
+
+	urlOpts := m.CallsTo("ProcessDocumentURL")[0].Args[0].(scheme.DocumentURLOptions)
+	assert.Equal(t, "https://example.com/receipt.jpg", urlOpts.FileURL)
+	assert.Equal(t, []string{"a", "b"}, urlOpts.Tags)
+
+	uploadOpts := m.CallsTo("ProcessDetailedDocumentUpload")[0].Args[0].(scheme.DocumentUploadOptions)
+	assert.Equal(t, "receipt.jpg", uploadOpts.FilePath)
+	assert.Equal(t, 2, *uploadOpts.MaxPagesToProcess)
+}
+
+func TestUnitCLI_SearchOutputFormats(t *testing.T) {
+	m := &mock.Client{
+		SearchDocumentsFunc: func(opts scheme.DocumentSearchOptions) (*scheme.Documents, error) {
+			return &scheme.Documents{Documents: []scheme.Document{{
+				ID:           7,
+				Date:         "2024-01-02 10:00:00",
+				Vendor:       scheme.Vendor{Name: "Walgreens"},
+				CurrencyCode: "USD",
+				Total:        scheme.MustParseAmount("29.53"),
+				Status:       scheme.Processed,
+			}}}, nil
+		},
+	}
+
+	code, stdout, stderr, _ := runCLI(t, m, credentials, "-o", "csv", "search", "-q", "coffee", "-status", "reviewed", "-created-gte", "2024-01-01")
+	assert.Equal(t, 0, code, stderr)
+	assert.Equal(t, "id,date,vendor,invoice_number,currency,subtotal,tax,total,status\n7,2024-01-02 10:00:00,Walgreens,,USD,0,0,29.53,processed\n", stdout)
+
+	opts := m.CallsTo("SearchDocuments")[0].Args[0].(scheme.DocumentSearchOptions)
+	assert.Equal(t, scheme.DocumentSearchOptions{Q: "coffee", Status: scheme.Reviewed, CreatedGTE: "2024-01-01"}, opts)
+
+	code, stdout, _, _ = runCLI(t, m, credentials, "-o", "table", "search")
+	assert.Equal(t, 0, code)
+	assert.Contains(t, stdout, "ID  DATE")
+	assert.Contains(t, stdout, "Walgreens")
+}
+
+func TestUnitCLI_LineItemsAddOnlySetsGivenFlags(t *testing.T) {
+	m := &mock.Client{
+		AddLineItemFunc: func(documentID string, opts scheme.LineItemOptions) (*scheme.LineItem, error) {
+			return &scheme.LineItem{ID: 3}, nil
+		},
+	}
+
+	code, stdout, stderr, _ := runCLI(t, m, credentials, "line-items", "add", "-description", "Coffee", "-total", "3.5", "42")
+	assert.Equal(t, 0, code, stderr)
+	assert.Contains(t, stdout, `"id": 3`)
+
+	call := m.CallsTo("AddLineItem")[0]
+	opts := call.Args[1].(scheme.LineItemOptions)
+	assert.Equal(t, "42", call.Args[0])
+	assert.Equal(t, "Coffee", *opts.Description)
+	assert.Equal(t, scheme.MustParseAmount("3.5"), *opts.Total)
+	assert.Nil(t, opts.Price)
+}
+
+func TestUnitCLI_Credentials(t *testing.T) {
+	dir := t.TempDir()
+	config := filepath.Join(dir, "config.json")
+	err := os.WriteFile(config, []byte(`{"profiles": {
+		"default": {"client_id": "defaultClientID", "api_key": "vrfk_default"},
+		"sandbox": {"client_id": "sandboxClientID", "api_key": "vrfk_sandbox", "environment_url": "sandbox.veryfi.com"}
+	}}`), 0o600)
+	assert.NoError(t, err)
+
+	m := &mock.Client{DeleteDocumentFunc: func(documentID string) error { return nil }}
+
+	// The default profile is used when nothing else is set.
+	code, _, stderr, opts := runCLI(t, m, map[string]string{veryfi.EnvConfigFile: config}, "delete", "1")
+	assert.Equal(t, 0, code, stderr)
+	assert.Equal(t, "defaultClientID", opts.ClientID)
+
+	// Environment variables override the profile and flags override both.
+	code, _, stderr, opts = runCLI(t, m, map[string]string{veryfi.EnvConfigFile: config, veryfi.EnvProfile: "sandbox", veryfi.EnvAPIKey: "vrfk_envKey"},
+		"-client-id", "flagClientID", "delete", "1")
+	assert.Equal(t, 0, code, stderr)
+	assert.Equal(t, "flagClientID", opts.ClientID)
+	assert.Equal(t, "vrfk_envKey", opts.APIKey)
+	assert.Equal(t, "sandbox.veryfi.com", opts.EnvironmentURL)
+
+	// An explicitly selected profile must exist.
+	code, _, stderr, _ = runCLI(t, m, map[string]string{veryfi.EnvConfigFile: config}, "-profile", "production", "delete", "1")
+	assert.Equal(t, 1, code)
+	assert.Contains(t, stderr, `profile "production" not found`)
+
+	// An explicitly selected config file must exist.
+	code, _, stderr, _ = runCLI(t, m, map[string]string{veryfi.EnvConfigFile: filepath.Join(dir, "missing.json")}, "delete", "1")
+	assert.Equal(t, 1, code)
+	assert.Contains(t, stderr, "fail to read config file")
+
+	// Missing credentials are reported before any call is made.
+	code, _, stderr, _ = runCLI(t, m, map[string]string{veryfi.EnvConfigFile: config, veryfi.EnvAPIKey: "legacyKey"}, "delete", "1")
+	assert.Equal(t, 1, code)
+	assert.Contains(t, stderr, "username is missing")
+}
+
+func TestUnitCLI_HelpWithoutCredentials(t *testing.T) {
+	m := &mock.Client{}
+	noCredentials := map[string]string{veryfi.EnvConfigFile: filepath.Join(t.TempDir(), "missing.json")}
+
+	code, _, stderr, opts := runCLI(t, m, map[string]string{}, "process", "-h")
+	assert.Equal(t, 0, code, stderr)
+	assert.Contains(t, stderr, "Usage: veryfi process")
+	assert.Nil(t, opts)
+
+	code, _, stderr, opts = runCLI(t, m, noCredentials, "line-items", "add", "-total", "abc", "42")
+	assert.Equal(t, 1, code)
+	assert.Contains(t, stderr, `invalid value "abc" for flag -total`)
+	assert.NotContains(t, stderr, "config file")
+	assert.Nil(t, opts)
+
+	code, _, stderr, _ = runCLI(t, m, map[string]string{}, "get")
+	assert.Equal(t, 1, code)
+	assert.Contains(t, stderr, "expected 1 argument(s), got 0")
+	assert.Empty(t, m.Calls())
+}
@@ -0,0 +1,200 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/pkg/errors"
+
+	"github.com/veryfi/veryfi-go/v4/veryfi/scheme"
+)
+
+const (
+	formatJSON  = "json"
+	formatTable = "table"
+	formatCSV   = "csv"
+)
+
+// deleted is the result of a delete command.
+type deleted struct {
+	Kind    string `json:"kind"`
+	ID      string `json:"id"`
+	Deleted bool   `json:"deleted"`
+}
+
+// table is the tabular form of a result.
+type table struct {
+	header []string
+	rows   [][]string
+}
+
+// render writes v to w in the given format.
+func render(w io.Writer, format string, v interface{}) error {
+	return renderStream(w, format, v, true)
+}
+
+// renderStream writes v to w in the given format. For a stream of results of
+// the same type, header is only true for the first one so that table and CSV
+// output carry a single header line.
+func renderStream(w io.Writer, format string, v interface{}, header bool) error {
+	if format == formatJSON {
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(v)
+	}
+
+	t, err := toTable(v)
+	if err != nil {
+		return err
+	}
+	if !header {
+		t.header = nil
+	}
+
+	switch format {
+	case formatCSV:
+		cw := csv.NewWriter(w)
+		if t.header != nil {
+			if err := cw.Write(t.header); err != nil {
+				return err
+			}
+		}
+		if err := cw.WriteAll(t.rows); err != nil {
+			return err
+		}
+		return cw.Error()
+	case formatTable:
+		tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+		if t.header != nil {
+			fmt.Fprintln(tw, strings.ToUpper(strings.Join(t.header, "\t")))
+		}
+		for _, row := range t.rows {
+			fmt.Fprintln(tw, strings.Join(row, "\t"))
+		}
+		return tw.Flush()
+	}
+
+	return errors.Errorf("unknown output format %q", format)
+}
+
+// toTable converts a result to its tabular form.
+func toTable(v interface{}) (table, error) {
+	documentHeader := []string{"id", "date", "vendor", "invoice_number", "currency", "subtotal", "tax", "total", "status"}
+	lineItemHeader := []string{"id", "order", "description", "quantity", "price", "tax", "total"}
+	tagHeader := []string{"id", "name"}
+
+	switch r := v.(type) {
+	case *scheme.Document:
+		return table{documentHeader, [][]string{documentRow(r)}}, nil
+	case *scheme.Documents:
+		t := table{header: documentHeader}
+		for i := range r.Documents {
+			t.rows = append(t.rows, documentRow(&r.Documents[i]))
+		}
+		return t, nil
+	case *scheme.DetailedDocument:
+		return table{documentHeader, [][]string{detailedDocumentRow(r)}}, nil
+	case *scheme.DetailedDocuments:
+		t := table{header: documentHeader}
+		for i := range r.Documents {
+			t.rows = append(t.rows, detailedDocumentRow(&r.Documents[i]))
+		}
+		return t, nil
+	case *scheme.LineItem:
+		return table{lineItemHeader, [][]string{lineItemRow(r)}}, nil
+	case *scheme.LineItems:
+		t := table{header: lineItemHeader}
+		for i := range r.LineItems {
+			t.rows = append(t.rows, lineItemRow(&r.LineItems[i]))
+		}
+		return t, nil
+	case *scheme.Tag:
+		return table{tagHeader, [][]string{{strconv.Itoa(r.ID), r.Name}}}, nil
+	case *scheme.Tags:
+		t := table{header: tagHeader}
+		for _, tag := range r.Tags {
+			t.rows = append(t.rows, []string{strconv.Itoa(tag.ID), tag.Name})
+		}
+		return t, nil
+	case watchEvent:
+		return table{
+			[]string{"path", "status", "document_id", "error"},
+			[][]string{{r.Path, string(r.Status), strconv.Itoa(r.DocumentID), r.Error}},
+		}, nil
+	case deleted:
+		return table{[]string{"kind", "id", "deleted"}, [][]string{{r.Kind, r.ID, strconv.FormatBool(r.Deleted)}}}, nil
+	}
+
+	return table{}, errors.Errorf("%T has no tabular form, use -o json", v)
+}
+
+// documentRow returns the table row of a document.
+func documentRow(d *scheme.Document) []string {
+	return []string{
+		strconv.Itoa(d.ID),
+		d.Date,
+		d.Vendor.Name,
+		d.InvoiceNumber,
+		d.CurrencyCode,
+		d.Subtotal.String(),
+		d.Tax.String(),
+		d.Total.String(),
+		string(d.Status),
+	}
+}
+
+// detailedDocumentRow returns the table row of a detailed document.
+func detailedDocumentRow(d *scheme.DetailedDocument) []string {
+	vendor := ""
+	if d.Vendor != nil {
+		vendor = textValue(d.Vendor.Name)
+	}
+
+	return []string{
+		strconv.Itoa(d.ID),
+		textValue(d.Date),
+		vendor,
+		textValue(d.InvoiceNumber),
+		textValue(d.CurrencyCode),
+		floatValue(d.Subtotal),
+		floatValue(d.Tax),
+		floatValue(d.Total),
+		string(d.Status),
+	}
+}
+
+// lineItemRow returns the table row of a line item.
+func lineItemRow(li *scheme.LineItem) []string {
+	return []string{
+		strconv.Itoa(li.ID),
+		strconv.Itoa(li.Order),
+		li.Description,
+		formatFloat(li.Quantity),
+		li.Price.String(),
+		li.Tax.String(),
+		li.Total.String(),
+	}
+}
+
+// formatFloat formats a number without trailing zeros.
+func formatFloat(v float64) string {
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
+
+// textValue returns the value of a detailed text or date field, or "" if unset.
+func textValue(f *scheme.DetailedField) string {
+	return f.ValueOr("")
+}
+
+// floatValue returns the value of a detailed numeric field, or "" if unset.
+func floatValue(f *scheme.DetailedFloatField) string {
+	if v, ok := f.Get(); ok {
+		return formatFloat(v)
+	}
+	return ""
+}
@@ -0,0 +1,77 @@
+package main
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/veryfi/veryfi-go/v4/veryfi/scheme"
+)
+
+// runTags dispatches the tags subcommands.
+func runTags(e *env, args []string) error {
+	const usage = "list <document-id> | add <document-id> <name> | delete <document-id> <tag-id> | list-global | delete-global <tag-id>"
+	if len(args) == 0 {
+		return errors.Errorf("usage: veryfi tags %s", usage)
+	}
+
+	action, args := args[0], args[1:]
+	fs := newFlagSet(e, "tags "+action, "<arguments>")
+	switch action {
+	case "list":
+		args, err := parseArgs(fs, args, 1)
+		if err != nil {
+			return err
+		}
+		api, err := e.client()
+		if err != nil {
+			return err
+		}
+		return result(e)(api.GetTags(args[0]))
+	case "add":
+		args, err := parseArgs(fs, args, 2)
+		if err != nil {
+			return err
+		}
+		api, err := e.client()
+		if err != nil {
+			return err
+		}
+		return result(e)(api.AddTag(args[0], scheme.TagOptions{Name: args[1]}))
+	case "delete":
+		args, err := parseArgs(fs, args, 2)
+		if err != nil {
+			return err
+		}
+		api, err := e.client()
+		if err != nil {
+			return err
+		}
+		if err := api.DeleteTag(args[0], args[1]); err != nil {
+			return err
+		}
+		return render(e.out, e.format, deleted{Kind: "tag", ID: args[1], Deleted: true})
+	case "list-global":
+		if _, err := parseArgs(fs, args, 0); err != nil {
+			return err
+		}
+		api, err := e.client()
+		if err != nil {
+			return err
+		}
+		return result(e)(api.GetGlobalTags())
+	case "delete-global":
+		args, err := parseArgs(fs, args, 1)
+		if err != nil {
+			return err
+		}
+		api, err := e.client()
+		if err != nil {
+			return err
+		}
+		if err := api.DeleteGlobalTag(args[0]); err != nil {
+			return err
+		}
+		return render(e.out, e.format, deleted{Kind: "global_tag", ID: args[0], Deleted: true})
+	}
+
+	return errors.Errorf("unknown action %q, usage: veryfi tags %s", action, usage)
+}
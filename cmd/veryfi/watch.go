@@ -0,0 +1,78 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/veryfi/veryfi-go/v4/veryfi/ingest"
+	"github.com/veryfi/veryfi-go/v4/veryfi/scheme"
+)
+
+// watchEvent is the rendered outcome of a file handled by the watch command.
+type watchEvent struct {
+	Path       string        `json:"path"`
+	Status     ingest.Status `json:"status"`
+	DocumentID int           `json:"document_id,omitempty"`
+	Error      string        `json:"error,omitempty"`
+}
+
+// runWatch polls a directory and uploads the files dropped into it until
+// interrupted.
+func runWatch(e *env, args []string) error {
+	fs := newFlagSet(e, "watch", "[flags] <directory>")
+	opts := ingest.Options{}
+	shared := scheme.DocumentSharedOptions{}
+	fs.StringVar(&opts.DoneDir, "done", "", "directory for uploaded files (default <directory>/done)")
+	fs.StringVar(&opts.FailedDir, "failed", "", "directory for failed files (default <directory>/failed)")
+	fs.StringVar(&opts.StateFile, "state", "", "state file recording uploads (default <directory>/.veryfi-ingest.json)")
+	fs.DurationVar(&opts.Interval, "interval", 0, "time between polls (default 10s)")
+	fs.DurationVar(&opts.SettleTime, "settle", 0, "time a file must go unmodified before upload (default 5s)")
+	fs.Var((*listFlag)(&opts.Patterns), "patterns", `comma-separated file name patterns, e.g. "*.pdf,*.jpg"`)
+	fs.BoolVar(&opts.ExternalIDFromFileName, "external-id-from-filename", false, "use the file name without extension as external ID")
+	registerSharedFlags(fs, &shared)
+
+	args, err := parseArgs(fs, args, 1)
+	if err != nil {
+		return err
+	}
+	api, err := e.client()
+	if err != nil {
+		return err
+	}
+	opts.Dir = args[0]
+	opts.Shared = shared
+
+	w, err := ingest.NewWatcher(api, opts)
+	if err != nil {
+		return err
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	fmt.Fprintf(e.errOut, "watching %s, press Ctrl+C to stop\n", opts.Dir)
+	var renderErr error
+	header := true
+	err = w.Run(ctx, func(r ingest.Result) {
+		ev := watchEvent{Path: r.Path, Status: r.Status, DocumentID: r.DocumentID}
+		if r.Err != nil {
+			ev.Error = r.Err.Error()
+		}
+		if err := renderStream(e.out, e.format, ev, header); err != nil && renderErr == nil {
+			renderErr = err
+			stop()
+		}
+		header = false
+	})
+	if renderErr != nil {
+		return renderErr
+	}
+	if err == context.Canceled {
+		return nil
+	}
+
+	return err
+}
@@ -1,4 +1,4 @@
-module github.com/veryfi/veryfi-go/v3
+module github.com/veryfi/veryfi-go/v4
 
 go 1.23
 
@@ -7,13 +7,13 @@ require (
 	github.com/go-resty/resty/v2 v2.13.1
 	github.com/pkg/errors v0.9.1
 	github.com/stretchr/testify v1.9.0
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 require (
 	github.com/davecgh/go-spew v1.1.1 // indirect
 	github.com/pmezard/go-difflib v1.0.0 // indirect
 	golang.org/x/net v0.29.0 // indirect
-	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
 
-replace github.com/veryfi/veryfi-go/v3 => ./
+replace github.com/veryfi/veryfi-go/v4 => ./
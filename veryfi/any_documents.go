@@ -0,0 +1,84 @@
+package veryfi
+
+import (
+	"fmt"
+
+	"github.com/veryfi/veryfi-go/v4/veryfi/scheme"
+)
+
+// ProcessAnyDocumentUpload returns a file processed with a blueprint.
+func (c *Client) ProcessAnyDocumentUpload(opts scheme.AnyDocumentUploadOptions) (*scheme.AnyDocument, error) {
+	out := new(*scheme.AnyDocument)
+	encodedFile, err := Base64EncodeFile(opts.FilePath)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := scheme.AnyDocumentUploadBase64Options{
+		FileData:                 encodedFile,
+		AnyDocumentSharedOptions: opts.AnyDocumentSharedOptions,
+	}
+	if err := c.post(anyDocumentURI, payload, out); err != nil {
+		return nil, err
+	}
+
+	return *out, nil
+}
+
+// ProcessDetailedAnyDocumentUpload returns a file processed with a blueprint,
+// with confidence scores and bounding boxes on every extracted field.
+func (c *Client) ProcessDetailedAnyDocumentUpload(opts scheme.AnyDocumentUploadOptions) (*scheme.AnyDocument, error) {
+	opts.ConfidenceDetails = true
+	opts.BoundingBoxes = true
+	return c.ProcessAnyDocumentUpload(opts)
+}
+
+// ProcessAnyDocumentURL returns a file processed with a blueprint using URL.
+func (c *Client) ProcessAnyDocumentURL(opts scheme.AnyDocumentURLOptions) (*scheme.AnyDocument, error) {
+	out := new(*scheme.AnyDocument)
+	if err := c.post(anyDocumentURI, opts, out); err != nil {
+		return nil, err
+	}
+
+	return *out, nil
+}
+
+// ProcessDetailedAnyDocumentURL returns a file processed with a blueprint
+// using URL, with confidence scores and bounding boxes on every extracted
+// field.
+func (c *Client) ProcessDetailedAnyDocumentURL(opts scheme.AnyDocumentURLOptions) (*scheme.AnyDocument, error) {
+	opts.ConfidenceDetails = true
+	opts.BoundingBoxes = true
+	return c.ProcessAnyDocumentURL(opts)
+}
+
+// GetAnyDocument returns a document processed with a blueprint.
+func (c *Client) GetAnyDocument(documentID string, opts scheme.AnyDocumentGetOptions) (*scheme.AnyDocument, error) {
+	out := new(*scheme.AnyDocument)
+	if err := c.get(fmt.Sprintf("%s%s", anyDocumentURI, documentID), opts, out); err != nil {
+		return nil, err
+	}
+
+	return *out, nil
+}
+
+// SearchAnyDocuments returns a list of documents processed with a blueprint
+// with matching queries.
+func (c *Client) SearchAnyDocuments(opts scheme.AnyDocumentSearchOptions) (*scheme.AnyDocuments, error) {
+	out := new(*scheme.AnyDocuments)
+	if err := c.get(anyDocumentURI, opts, out); err != nil {
+		return nil, err
+	}
+
+	return *out, nil
+}
+
+// DeleteAnyDocument deletes a document processed with a blueprint.
+func (c *Client) DeleteAnyDocument(documentID string) error {
+	err := c.rdelete(fmt.Sprintf("%s%s", anyDocumentURI, documentID))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,198 @@
+package veryfi
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/veryfi/veryfi-go/v4/veryfi/scheme"
+	"github.com/veryfi/veryfi-go/v4/veryfi/test"
+)
+
+const mockAnyDocument = `{
+	"id": 4662609,
+	"blueprint_name": "us_driver_license",
+	"created_date": "2024-05-20 17:34:20",
+	"img_url": "https://scdn.veryfi.com/any-documents/img.png",
+	"first_name": "JANICE",
+	"last_name": "SAMPLE",
+	"license_number": "I1234568",
+	"address": {"street": "123 MAIN STREET", "state": "CA"},
+	"endorsements": ["NONE"]
+}`
+
+const mockDetailedAnyDocument = `{
+	"id": 4662609,
+	"blueprint_name": "us_driver_license",
+	"first_name": {
+		"value": "JANICE",
+		"score": 0.98,
+		"ocr_score": 0.99,
+		"bounding_box": [0, 0.1, 0.2, 0.3, 0.4],
+		"bounding_region": [0.1, 0.2, 0.3, 0.2, 0.3, 0.4, 0.1, 0.4]
+	},
+	"last_name": "SAMPLE"
+}`
+
+type driverLicense struct {
+	ID            int    `json:"id"`
+	FirstName     string `json:"first_name"`
+	LastName      string `json:"last_name"`
+	LicenseNumber string `json:"license_number"`
+	Address       struct {
+		Street string `json:"street"`
+		State  string `json:"state"`
+	} `json:"address"`
+}
+
+func TestUnitClientV8_ProcessAnyDocumentURL(t *testing.T) {
+	server := test.NewHTTPServer()
+	defer server.Close()
+
+	body := map[string]any{}
+	server.Handle("/api/v8/partner/any-documents/", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(mockAnyDocument))
+	})
+
+	client := newTestClient(t, server)
+	resp, err := client.ProcessAnyDocumentURL(scheme.AnyDocumentURLOptions{
+		FileURL: "https://example.com/license.png",
+		AnyDocumentSharedOptions: scheme.AnyDocumentSharedOptions{
+			BlueprintName: "us_driver_license",
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "us_driver_license", body["blueprint_name"])
+	assert.Equal(t, "https://example.com/license.png", body["file_url"])
+
+	assert.Equal(t, 4662609, resp.ID)
+	assert.Equal(t, "us_driver_license", resp.BlueprintName)
+	assert.Equal(t, "2024-05-20 17:34:20", resp.Created)
+	assert.Equal(t, "JANICE", resp.Fields["first_name"])
+	assert.NotContains(t, resp.Fields, "id")
+	assert.NotContains(t, resp.Fields, "blueprint_name")
+
+	license, err := scheme.DecodeAnyDocument[driverLicense](resp)
+	assert.NoError(t, err)
+	assert.Equal(t, 4662609, license.ID)
+	assert.Equal(t, "SAMPLE", license.LastName)
+	assert.Equal(t, "I1234568", license.LicenseNumber)
+	assert.Equal(t, "CA", license.Address.State)
+
+	_, ok := resp.DetailedField("first_name")
+	assert.False(t, ok)
+}
+
+func TestUnitClientV8_ProcessDetailedAnyDocumentUpload(t *testing.T) {
+	server := test.NewHTTPServer()
+	defer server.Close()
+
+	body := map[string]any{}
+	server.Handle("/api/v8/partner/any-documents/", func(w http.ResponseWriter, r *http.Request) {
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(mockDetailedAnyDocument))
+	})
+
+	client := newTestClient(t, server)
+	resp, err := client.ProcessDetailedAnyDocumentUpload(scheme.AnyDocumentUploadOptions{
+		FilePath: "testdata/receipt_public.jpg",
+		AnyDocumentSharedOptions: scheme.AnyDocumentSharedOptions{
+			BlueprintName: "us_driver_license",
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, true, body["confidence_details"])
+	assert.Equal(t, true, body["bounding_boxes"])
+	assert.NotEmpty(t, body["file_data"])
+
+	field, ok := resp.DetailedField("first_name")
+	assert.True(t, ok)
+	assert.Equal(t, "JANICE", field.Value)
+	assert.Equal(t, 0.98, *field.Score)
+	assert.Len(t, field.BoundingRegion, 8)
+
+	_, ok = resp.DetailedField("last_name")
+	assert.False(t, ok)
+	_, ok = resp.DetailedField("missing")
+	assert.False(t, ok)
+}
+
+func TestUnitClientV8_ManageAnyDocuments(t *testing.T) {
+	server := test.NewHTTPServer()
+	defer server.Close()
+
+	var query string
+	server.Handle("/api/v8/partner/any-documents/", func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query().Get("blueprint_name")
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"documents": [` + mockAnyDocument + `], "meta": {"total_pages": 1}}`))
+	})
+	server.Handle("/api/v8/partner/any-documents/4662609", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodDelete {
+			w.Write([]byte(`{"status": "ok"}`))
+			return
+		}
+		w.Write([]byte(mockAnyDocument))
+	})
+
+	client := newTestClient(t, server)
+	docs, err := client.SearchAnyDocuments(scheme.AnyDocumentSearchOptions{BlueprintName: "us_driver_license"})
+	assert.NoError(t, err)
+	assert.Equal(t, "us_driver_license", query)
+	assert.Len(t, docs.Documents, 1)
+	assert.Equal(t, "SAMPLE", docs.Documents[0].Fields["last_name"])
+
+	doc, err := client.GetAnyDocument("4662609", scheme.AnyDocumentGetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, 4662609, doc.ID)
+
+	assert.NoError(t, client.DeleteAnyDocument("4662609"))
+}
+
+func TestUnitAnyDocument_MarshalJSON(t *testing.T) {
+	doc := scheme.AnyDocument{}
+	assert.NoError(t, json.Unmarshal([]byte(mockAnyDocument), &doc))
+
+	data, err := json.Marshal(doc)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{
+		"id": 4662609,
+		"blueprint_name": "us_driver_license",
+		"created_date": "2024-05-20 17:34:20",
+		"img_url": "https://scdn.veryfi.com/any-documents/img.png",
+		"first_name": "JANICE",
+		"last_name": "SAMPLE",
+		"license_number": "I1234568",
+		"address": {"street": "123 MAIN STREET", "state": "CA"},
+		"endorsements": ["NONE"]
+	}`, string(data))
+
+	// Decode reads Fields rather than Raw, so edits are seen.
+	doc.Fields["license_number"] = "I7654321"
+	out := map[string]any{}
+	assert.NoError(t, doc.Decode(&out))
+	assert.Equal(t, "I7654321", out["license_number"])
+}
+
+func TestUnitAnyDocument_LargeNumbers(t *testing.T) {
+	doc := scheme.AnyDocument{}
+	assert.NoError(t, json.Unmarshal([]byte(`{"id": 1, "account_number": 9007199254740993}`), &doc))
+	assert.Equal(t, json.Number("9007199254740993"), doc.Fields["account_number"])
+
+	data, err := json.Marshal(doc)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"id": 1, "blueprint_name": "", "account_number": 9007199254740993}`, string(data))
+	assert.Contains(t, string(data), "9007199254740993")
+
+	account, err := scheme.DecodeAnyDocument[struct {
+		AccountNumber int64 `json:"account_number"`
+	}](&doc)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(9007199254740993), account.AccountNumber)
+}
@@ -0,0 +1,226 @@
+package veryfi
+
+import (
+	"context"
+	"crypto/tls"
+	"io"
+	"time"
+
+	"github.com/veryfi/veryfi-go/v4/veryfi/scheme"
+)
+
+// API describes every public operation of a Veryfi API Client. Depend on API
+// rather than *Client so that a fake, such as the one in the veryfi/mock
+// package, can be swapped in for tests.
+type API interface {
+	// Config returns the client configuration options.
+	Config() *Options
+
+	// SetTLSConfig sets the TLS configurations for underling transportation layer.
+	SetTLSConfig(config *tls.Config)
+
+	// WithResponse returns a copy of the client that records the metadata of
+	// the response to each call in meta.
+	WithResponse(meta *ResponseMeta) API
+
+	// ProcessDocumentUpload returns the processed document.
+	ProcessDocumentUpload(opts scheme.DocumentUploadOptions) (*scheme.Document, error)
+
+	// ProcessDetailedDocumentUpload returns the processed document with confidence scores and bounding boxes.
+	ProcessDetailedDocumentUpload(opts scheme.DocumentUploadOptions) (*scheme.DetailedDocument, error)
+
+	// ProcessDocumentURL returns the processed document using URL.
+	ProcessDocumentURL(opts scheme.DocumentURLOptions) (*scheme.Document, error)
+
+	// ProcessDetailedDocumentURL returns the processed document using URL with confidence scores and bounding boxes.
+	ProcessDetailedDocumentURL(opts scheme.DocumentURLOptions) (*scheme.DetailedDocument, error)
+
+	// UpdateDocument updates and returns the processed document.
+	UpdateDocument(documentID string, opts scheme.DocumentUpdateOptions) (*scheme.Document, error)
+
+	// SearchDocuments returns a list of processed documents with matching queries.
+	SearchDocuments(opts scheme.DocumentSearchOptions) (*scheme.Documents, error)
+
+	// SearchDetailedDocuments returns a list of processed documents with matching queries.
+	SearchDetailedDocuments(opts scheme.DocumentSearchOptions) (*scheme.DetailedDocuments, error)
+
+	// GetDocument returns a processed document with matching queries.
+	GetDocument(documentID string, opts scheme.DocumentGetOptions) (*scheme.Document, error)
+
+	// GetDetailedDocument returns a processed document with detailed field information.
+	GetDetailedDocument(documentID string, opts scheme.DocumentGetOptions) (*scheme.DetailedDocument, error)
+
+	// DeleteDocument deletes a processed document.
+	DeleteDocument(documentID string) error
+
+	// GetLineItems returns all line items for a processed document.
+	GetLineItems(documentID string) (*scheme.LineItems, error)
+
+	// AddLineItem returns a added line item for a processed document.
+	AddLineItem(documentID string, opts scheme.LineItemOptions) (*scheme.LineItem, error)
+
+	// GetLineItem returns a line item for a processed document.
+	GetLineItem(documentID string, lineItemID string) (*scheme.LineItem, error)
+
+	// UpdateLineItem returns an updated line item for a processed document.
+	UpdateLineItem(documentID string, lineItemID string, opts scheme.LineItemOptions) (*scheme.LineItem, error)
+
+	// DeleteLineItem deletes a line item in a document.
+	DeleteLineItem(documentID string, lineItemID string) error
+
+	// GetTags returns all tags for a processed document.
+	GetTags(documentID string) (*scheme.Tags, error)
+
+	// GetGlobalTags returns all globally existing tags.
+	GetGlobalTags() (*scheme.Tags, error)
+
+	// AddTag returns an added tag for a processed document.
+	AddTag(documentID string, opts scheme.TagOptions) (*scheme.Tag, error)
+
+	// DeleteTag deletes a tag from a document.
+	DeleteTag(documentID string, tagID string) error
+
+	// DeleteGlobalTag deletes a tag from all documents.
+	DeleteGlobalTag(tagID string) error
+
+	// ProcessAnyDocumentUpload returns a file processed with a blueprint.
+	ProcessAnyDocumentUpload(opts scheme.AnyDocumentUploadOptions) (*scheme.AnyDocument, error)
+
+	// ProcessDetailedAnyDocumentUpload returns a file processed with a blueprint, with confidence scores and bounding boxes.
+	ProcessDetailedAnyDocumentUpload(opts scheme.AnyDocumentUploadOptions) (*scheme.AnyDocument, error)
+
+	// ProcessAnyDocumentURL returns a file processed with a blueprint using URL.
+	ProcessAnyDocumentURL(opts scheme.AnyDocumentURLOptions) (*scheme.AnyDocument, error)
+
+	// ProcessDetailedAnyDocumentURL returns a file processed with a blueprint using URL, with confidence scores and bounding boxes.
+	ProcessDetailedAnyDocumentURL(opts scheme.AnyDocumentURLOptions) (*scheme.AnyDocument, error)
+
+	// GetAnyDocument returns a document processed with a blueprint.
+	GetAnyDocument(documentID string, opts scheme.AnyDocumentGetOptions) (*scheme.AnyDocument, error)
+
+	// SearchAnyDocuments returns a list of documents processed with a blueprint with matching queries.
+	SearchAnyDocuments(opts scheme.AnyDocumentSearchOptions) (*scheme.AnyDocuments, error)
+
+	// DeleteAnyDocument deletes a document processed with a blueprint.
+	DeleteAnyDocument(documentID string) error
+
+	// ProcessBankStatementUpload returns the processed bank statement.
+	ProcessBankStatementUpload(opts scheme.BankStatementUploadOptions) (*scheme.BankStatement, error)
+
+	// ProcessBankStatementURL returns the processed bank statement using URL.
+	ProcessBankStatementURL(opts scheme.BankStatementURLOptions) (*scheme.BankStatement, error)
+
+	// GetBankStatement returns a processed bank statement.
+	GetBankStatement(documentID string, opts scheme.BankStatementGetOptions) (*scheme.BankStatement, error)
+
+	// SearchBankStatements returns a list of processed bank statements with matching queries.
+	SearchBankStatements(opts scheme.BankStatementSearchOptions) (*scheme.BankStatements, error)
+
+	// DeleteBankStatement deletes a processed bank statement.
+	DeleteBankStatement(documentID string) error
+
+	// ProcessCheckUpload returns the processed check, uploading its front and, optionally, its back.
+	ProcessCheckUpload(opts scheme.CheckUploadOptions) (*scheme.Check, error)
+
+	// ProcessCheckURL returns the processed check using URL.
+	ProcessCheckURL(opts scheme.CheckURLOptions) (*scheme.Check, error)
+
+	// GetCheck returns a processed check.
+	GetCheck(documentID string, opts scheme.CheckGetOptions) (*scheme.Check, error)
+
+	// SearchChecks returns a list of processed checks with matching queries.
+	SearchChecks(opts scheme.CheckSearchOptions) (*scheme.Checks, error)
+
+	// DeleteCheck deletes a processed check.
+	DeleteCheck(documentID string) error
+
+	// ProcessW2Upload returns the processed W-2 form.
+	ProcessW2Upload(opts scheme.TaxFormUploadOptions) (*scheme.W2Form, error)
+
+	// ProcessW2URL returns the processed W-2 form using URL.
+	ProcessW2URL(opts scheme.TaxFormURLOptions) (*scheme.W2Form, error)
+
+	// GetW2 returns a processed W-2 form.
+	GetW2(documentID string) (*scheme.W2Form, error)
+
+	// SearchW2s returns a list of processed W-2 forms with matching queries.
+	SearchW2s(opts scheme.TaxFormSearchOptions) (*scheme.W2Forms, error)
+
+	// DeleteW2 deletes a processed W-2 form.
+	DeleteW2(documentID string) error
+
+	// ProcessW9Upload returns the processed W-9 form.
+	ProcessW9Upload(opts scheme.TaxFormUploadOptions) (*scheme.W9Form, error)
+
+	// ProcessW9URL returns the processed W-9 form using URL.
+	ProcessW9URL(opts scheme.TaxFormURLOptions) (*scheme.W9Form, error)
+
+	// GetW9 returns a processed W-9 form.
+	GetW9(documentID string) (*scheme.W9Form, error)
+
+	// SearchW9s returns a list of processed W-9 forms with matching queries.
+	SearchW9s(opts scheme.TaxFormSearchOptions) (*scheme.W9Forms, error)
+
+	// DeleteW9 deletes a processed W-9 form.
+	DeleteW9(documentID string) error
+
+	// ProcessW8Upload returns the processed W-8 form.
+	ProcessW8Upload(opts scheme.TaxFormUploadOptions) (*scheme.W8Form, error)
+
+	// ProcessW8URL returns the processed W-8 form using URL.
+	ProcessW8URL(opts scheme.TaxFormURLOptions) (*scheme.W8Form, error)
+
+	// GetW8 returns a processed W-8 form.
+	GetW8(documentID string) (*scheme.W8Form, error)
+
+	// SearchW8s returns a list of processed W-8 forms with matching queries.
+	SearchW8s(opts scheme.TaxFormSearchOptions) (*scheme.W8Forms, error)
+
+	// DeleteW8 deletes a processed W-8 form.
+	DeleteW8(documentID string) error
+
+	// ProcessBusinessCardUpload returns the processed business card.
+	ProcessBusinessCardUpload(opts scheme.BusinessCardUploadOptions) (*scheme.BusinessCard, error)
+
+	// ProcessBusinessCardURL returns the processed business card using URL.
+	ProcessBusinessCardURL(opts scheme.BusinessCardURLOptions) (*scheme.BusinessCard, error)
+
+	// GetBusinessCard returns a processed business card.
+	GetBusinessCard(documentID string) (*scheme.BusinessCard, error)
+
+	// SearchBusinessCards returns a list of processed business cards with matching queries.
+	SearchBusinessCards(opts scheme.BusinessCardSearchOptions) (*scheme.BusinessCards, error)
+
+	// DeleteBusinessCard deletes a processed business card.
+	DeleteBusinessCard(documentID string) error
+
+	// ClassifyDocumentUpload returns the type of an uploaded file without processing it.
+	ClassifyDocumentUpload(opts scheme.ClassifyUploadOptions) (*scheme.Classification, error)
+
+	// ClassifyDocumentURL returns the type of a file using URL without processing it.
+	ClassifyDocumentURL(opts scheme.ClassifyURLOptions) (*scheme.Classification, error)
+
+	// ProcessSplitDocumentUpload submits a file holding several documents to be split and processed.
+	ProcessSplitDocumentUpload(opts scheme.DocumentUploadOptions) (*scheme.DocumentBatch, error)
+
+	// ProcessSplitDocumentURL submits a file holding several documents using URL to be split and processed.
+	ProcessSplitDocumentURL(opts scheme.DocumentURLOptions) (*scheme.DocumentBatch, error)
+
+	// GetDocumentBatch returns the current state of a document batch.
+	GetDocumentBatch(batchID string) (*scheme.DocumentBatch, error)
+
+	// WaitDocumentBatch polls a document batch until it is no longer in progress.
+	WaitDocumentBatch(ctx context.Context, batchID string, interval time.Duration) (*scheme.DocumentBatch, error)
+
+	// GetBatchDocuments returns the documents a finished batch was split into, with page ranges.
+	GetBatchDocuments(batchID string) ([]scheme.Document, error)
+
+	// DownloadDocument streams a file of a document to w, refreshing expired signed URLs.
+	DownloadDocument(doc *scheme.Document, kind DownloadKind, w io.Writer) (*Download, error)
+
+	// DownloadDocuments downloads the files of documents into a directory.
+	DownloadDocuments(docs []scheme.Document, dir string, opts BulkDownloadOptions) ([]DownloadedFile, error)
+}
+
+// Ensure Client satisfies the API interface.
+var _ API = (*Client)(nil)
@@ -0,0 +1,136 @@
+package veryfi
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// bearerKeyPrefix identifies new client-scoped API keys, which authenticate as a Bearer token.
+const bearerKeyPrefix = "vrfk_"
+
+// AuthRequest describes a request to authenticate.
+type AuthRequest struct {
+	// Method is the HTTP method, e.g. "POST".
+	Method string
+
+	// Path is the route relative to the API version, e.g. "/partner/documents/".
+	Path string
+
+	// Credentials are the credentials resolved for this request.
+	Credentials Credentials
+
+	// Params holds the fields of the body or query parameters, as signed by
+	// the legacy scheme.
+	Params map[string]string
+
+	// Timestamp is the time of the request.
+	Timestamp time.Time
+}
+
+// Authenticator returns the headers that authenticate a request. Set
+// Options.Authenticator to plug in a custom scheme. Implementations must be
+// safe for concurrent use.
+type Authenticator interface {
+	Authenticate(r *AuthRequest) (map[string]string, error)
+}
+
+// AuthenticatorFunc adapts a function to an Authenticator.
+type AuthenticatorFunc func(r *AuthRequest) (map[string]string, error)
+
+// Authenticate calls f.
+func (f AuthenticatorFunc) Authenticate(r *AuthRequest) (map[string]string, error) {
+	return f(r)
+}
+
+// Signer signs the canonical message of the legacy scheme, e.g. with a key
+// held by an external KMS.
+type Signer interface {
+	// Sign returns the base64-encoded signature of message.
+	Sign(creds Credentials, message []byte) (string, error)
+}
+
+// HMACSigner signs with HMAC-SHA256 keyed by the client secret. It is the
+// default Signer of LegacyAuthenticator.
+type HMACSigner struct{}
+
+// Sign implements Signer.
+func (HMACSigner) Sign(creds Credentials, message []byte) (string, error) {
+	h := hmac.New(sha256.New, []byte(creds.ClientSecret))
+	h.Write(message)
+	return base64.StdEncoding.EncodeToString(h.Sum(nil)), nil
+}
+
+// LegacyAuthenticator authenticates with the `apikey <username>:<key>`
+// Authorization header and a request signature.
+type LegacyAuthenticator struct {
+	// Signer signs each request. Defaults to HMACSigner.
+	Signer Signer
+}
+
+// Authenticate implements Authenticator.
+func (a LegacyAuthenticator) Authenticate(r *AuthRequest) (map[string]string, error) {
+	signer := a.Signer
+	if signer == nil {
+		signer = HMACSigner{}
+	}
+
+	timestamp := r.Timestamp.Unix()
+	signature, err := signer.Sign(r.Credentials, []byte(signatureMessage(r.Params, timestamp)))
+	if err != nil {
+		return nil, errors.Wrap(err, "fail to sign request")
+	}
+
+	return map[string]string{
+		"Client-Id":                  r.Credentials.ClientID,
+		"Authorization":              fmt.Sprintf("apikey %s:%s", r.Credentials.Username, r.Credentials.APIKey),
+		"X-Veryfi-Request-Timestamp": strconv.FormatInt(timestamp, 10),
+		"X-Veryfi-Request-Signature": signature,
+	}, nil
+}
+
+// BearerAuthenticator authenticates client-scoped keys with the
+// `Bearer <key>` Authorization header. Requests are not signed and no
+// username is needed.
+type BearerAuthenticator struct{}
+
+// Authenticate implements Authenticator.
+func (BearerAuthenticator) Authenticate(r *AuthRequest) (map[string]string, error) {
+	return map[string]string{
+		"Client-Id":     r.Credentials.ClientID,
+		"Authorization": "Bearer " + r.Credentials.APIKey,
+	}, nil
+}
+
+// AutoAuthenticator selects a scheme per request from the API key: keys
+// prefixed with "vrfk_" use Bearer, all others use Legacy. It is used when
+// Options.Authenticator is not set, and lets a key rotate from one format to
+// the other without reconfiguring the client.
+type AutoAuthenticator struct {
+	Legacy LegacyAuthenticator
+	Bearer BearerAuthenticator
+}
+
+// Authenticate implements Authenticator.
+func (a AutoAuthenticator) Authenticate(r *AuthRequest) (map[string]string, error) {
+	if strings.HasPrefix(r.Credentials.APIKey, bearerKeyPrefix) {
+		return a.Bearer.Authenticate(r)
+	}
+	return a.Legacy.Authenticate(r)
+}
+
+// signatureMessage builds the message signed by the legacy scheme.
+func signatureMessage(params map[string]string, timestamp int64) string {
+	p := []string{fmt.Sprintf("timestamp:%v", timestamp)}
+	for k, v := range params {
+		p = append(p, fmt.Sprintf("%v:%v", k, v))
+	}
+
+	return strings.Join(p, ",")
+}
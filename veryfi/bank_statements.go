@@ -0,0 +1,66 @@
+package veryfi
+
+import (
+	"fmt"
+
+	"github.com/veryfi/veryfi-go/v4/veryfi/scheme"
+)
+
+// ProcessBankStatementUpload returns the processed bank statement.
+func (c *Client) ProcessBankStatementUpload(opts scheme.BankStatementUploadOptions) (*scheme.BankStatement, error) {
+	out := new(*scheme.BankStatement)
+	encodedFile, err := Base64EncodeFile(opts.FilePath)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := scheme.BankStatementUploadBase64Options{
+		FileData:                   encodedFile,
+		BankStatementSharedOptions: opts.BankStatementSharedOptions,
+	}
+	if err := c.post(bankStatementURI, payload, out); err != nil {
+		return nil, err
+	}
+
+	return *out, nil
+}
+
+// ProcessBankStatementURL returns the processed bank statement using URL.
+func (c *Client) ProcessBankStatementURL(opts scheme.BankStatementURLOptions) (*scheme.BankStatement, error) {
+	out := new(*scheme.BankStatement)
+	if err := c.post(bankStatementURI, opts, out); err != nil {
+		return nil, err
+	}
+
+	return *out, nil
+}
+
+// GetBankStatement returns a processed bank statement.
+func (c *Client) GetBankStatement(documentID string, opts scheme.BankStatementGetOptions) (*scheme.BankStatement, error) {
+	out := new(*scheme.BankStatement)
+	if err := c.get(fmt.Sprintf("%s%s", bankStatementURI, documentID), opts, out); err != nil {
+		return nil, err
+	}
+
+	return *out, nil
+}
+
+// SearchBankStatements returns a list of processed bank statements with matching queries.
+func (c *Client) SearchBankStatements(opts scheme.BankStatementSearchOptions) (*scheme.BankStatements, error) {
+	out := new(*scheme.BankStatements)
+	if err := c.get(bankStatementURI, opts, out); err != nil {
+		return nil, err
+	}
+
+	return *out, nil
+}
+
+// DeleteBankStatement deletes a processed bank statement.
+func (c *Client) DeleteBankStatement(documentID string) error {
+	err := c.rdelete(fmt.Sprintf("%s%s", bankStatementURI, documentID))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
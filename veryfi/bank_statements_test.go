@@ -0,0 +1,102 @@
+package veryfi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/veryfi/veryfi-go/v4/veryfi/scheme"
+)
+
+var bankStatementsEndpoint = endpoint{uri: "bank-statements", id: "4559568", file: "bank_statement.json"}
+
+func expectedBankStatement() *scheme.BankStatement {
+	return &scheme.BankStatement{
+		ID:                   4559568,
+		ExternalID:           "stmt-2024-04",
+		Created:              "2024-05-02 18:46:44",
+		Updated:              "2024-05-02 18:46:59",
+		ImgFileName:          "4559568.png",
+		ImgURL:               "https://scdn.veryfi.com/bank-statements/img.png",
+		PDFURL:               "https://scdn.veryfi.com/bank-statements/statement.pdf",
+		AccountHolderAddress: "1234 MAIN ST, SAN MATEO CA 94401",
+		AccountHolderName:    "JANE DOE",
+		AccountNumber:        "000009752",
+		AccountType:          "checking",
+		BankAddress:          "PO BOX 1800, ROANOKE VA 24022",
+		BankName:             "Wells Fargo",
+		CurrencyCode:         "USD",
+		RoutingNumber:        "121000248",
+		StatementDate:        "2024-04-30",
+		PeriodStartDate:      "2024-04-01",
+		PeriodEndDate:        "2024-04-30",
+		BeginningBalance:     scheme.MustParseAmount("5372.87"),
+		EndingBalance:        scheme.MustParseAmount("6283.01"),
+		Summaries: []scheme.BankStatementSummary{
+			{Name: "Deposits/Credits", Total: scheme.MustParseAmount("2200")},
+			{Name: "Withdrawals/Debits", Total: scheme.MustParseAmount("1289.86")},
+		},
+		Transactions: []scheme.BankTransaction{
+			{
+				Order:        0,
+				Date:         "2024-04-03",
+				Description:  "PAYROLL ACME CORP",
+				CreditAmount: amountPtr("2200"),
+				Balance:      amountPtr("7572.87"),
+				Text:         "04/03 PAYROLL ACME CORP 2,200.00 7,572.87",
+			},
+			{
+				Order:       1,
+				Date:        "2024-04-15",
+				Description: "RENT PAYMENT",
+				DebitAmount: amountPtr("1289.86"),
+				Balance:     amountPtr("6283.01"),
+				CardNumber:  "1850",
+				Text:        "04/15 RENT PAYMENT 1,289.86 6,283.01",
+			},
+		},
+	}
+}
+
+func TestUnitClientV8_ProcessBankStatementUpload(t *testing.T) {
+	server, client, body := setUpEndpoints(t, bankStatementsEndpoint)
+	defer server.Close()
+
+	resp, err := client.ProcessBankStatementUpload(scheme.BankStatementUploadOptions{
+		FilePath: "testdata/receipt_public.jpg",
+		BankStatementSharedOptions: scheme.BankStatementSharedOptions{
+			ExternalID: "stmt-2024-04",
+		},
+	})
+	assert.NoError(t, err)
+	assertResponse(t, expectedBankStatement(), resp)
+	assert.NotEmpty(t, body["file_data"])
+	assert.Equal(t, "stmt-2024-04", body["external_id"])
+}
+
+func TestUnitClientV8_ProcessBankStatementURL(t *testing.T) {
+	server, client, body := setUpEndpoints(t, bankStatementsEndpoint)
+	defer server.Close()
+
+	resp, err := client.ProcessBankStatementURL(scheme.BankStatementURLOptions{
+		FileURL: "https://example.com/statement.pdf",
+	})
+	assert.NoError(t, err)
+	assertResponse(t, expectedBankStatement(), resp)
+	assert.Equal(t, "https://example.com/statement.pdf", body["file_url"])
+}
+
+func TestUnitClientV8_ManageBankStatements(t *testing.T) {
+	server, client, _ := setUpEndpoints(t, bankStatementsEndpoint)
+	defer server.Close()
+
+	statements, err := client.SearchBankStatements(scheme.BankStatementSearchOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, statements.Meta.TotalResults)
+	assertResponse(t, []scheme.BankStatement{*expectedBankStatement()}, statements.BankStatements)
+
+	resp, err := client.GetBankStatement("4559568", scheme.BankStatementGetOptions{})
+	assert.NoError(t, err)
+	assertResponse(t, expectedBankStatement(), resp)
+
+	assert.NoError(t, client.DeleteBankStatement("4559568"))
+}
@@ -0,0 +1,66 @@
+package veryfi
+
+import (
+	"fmt"
+
+	"github.com/veryfi/veryfi-go/v4/veryfi/scheme"
+)
+
+// ProcessBusinessCardUpload returns the processed business card.
+func (c *Client) ProcessBusinessCardUpload(opts scheme.BusinessCardUploadOptions) (*scheme.BusinessCard, error) {
+	out := new(*scheme.BusinessCard)
+	encodedFile, err := Base64EncodeFile(opts.FilePath)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := scheme.BusinessCardUploadBase64Options{
+		FileData:                  encodedFile,
+		BusinessCardSharedOptions: opts.BusinessCardSharedOptions,
+	}
+	if err := c.post(businessCardURI, payload, out); err != nil {
+		return nil, err
+	}
+
+	return *out, nil
+}
+
+// ProcessBusinessCardURL returns the processed business card using URL.
+func (c *Client) ProcessBusinessCardURL(opts scheme.BusinessCardURLOptions) (*scheme.BusinessCard, error) {
+	out := new(*scheme.BusinessCard)
+	if err := c.post(businessCardURI, opts, out); err != nil {
+		return nil, err
+	}
+
+	return *out, nil
+}
+
+// GetBusinessCard returns a processed business card.
+func (c *Client) GetBusinessCard(documentID string) (*scheme.BusinessCard, error) {
+	out := new(*scheme.BusinessCard)
+	if err := c.get(fmt.Sprintf("%s%s", businessCardURI, documentID), nil, out); err != nil {
+		return nil, err
+	}
+
+	return *out, nil
+}
+
+// SearchBusinessCards returns a list of processed business cards with matching queries.
+func (c *Client) SearchBusinessCards(opts scheme.BusinessCardSearchOptions) (*scheme.BusinessCards, error) {
+	out := new(*scheme.BusinessCards)
+	if err := c.get(businessCardURI, opts, out); err != nil {
+		return nil, err
+	}
+
+	return *out, nil
+}
+
+// DeleteBusinessCard deletes a processed business card.
+func (c *Client) DeleteBusinessCard(documentID string) error {
+	err := c.rdelete(fmt.Sprintf("%s%s", businessCardURI, documentID))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,56 @@
+package veryfi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/veryfi/veryfi-go/v4/veryfi/scheme"
+)
+
+func TestUnitClientV8_BusinessCards(t *testing.T) {
+	server, client, body := setUpEndpoints(t, endpoint{uri: "business-cards", id: "201", file: "business_card.json"})
+	defer server.Close()
+
+	expected := &scheme.BusinessCard{
+		ID:            201,
+		FullName:      "Jane Doe",
+		FirstName:     "Jane",
+		LastName:      "Doe",
+		JobTitles:     []string{"Head of Partnerships"},
+		CompanyNames:  []string{"Acme Inc."},
+		Phones:        []scheme.ContactPhone{{Number: "+1 555 010 0100", Type: "mobile"}},
+		Emails:        []string{"jane@acme.example"},
+		Addresses:     []scheme.ContactAddress{{Address: "1 Main St, Springfield, IL 62701", Type: "work"}},
+		Websites:      []string{"https://acme.example"},
+		SocialHandles: []scheme.SocialHandle{{Network: "LinkedIn", URL: "https://www.linkedin.com/in/janedoe"}},
+	}
+
+	card, err := client.ProcessBusinessCardUpload(scheme.BusinessCardUploadOptions{
+		FilePath: "testdata/receipt_public.jpg",
+	})
+	assert.NoError(t, err)
+	assert.NotEmpty(t, body["file_data"])
+	assertResponse(t, expected, card)
+	assert.Contains(t, card.VCard(), "TEL;VALUE=text;TYPE=cell:+1 555 010 0100\r\n")
+
+	card, err = client.ProcessBusinessCardURL(scheme.BusinessCardURLOptions{
+		FileURL: "https://example.com/card.jpg",
+		BusinessCardSharedOptions: scheme.BusinessCardSharedOptions{
+			ParseAddress: true,
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "https://example.com/card.jpg", body["file_url"])
+	assert.Equal(t, true, body["parse_address"])
+	assertResponse(t, expected, card)
+
+	cards, err := client.SearchBusinessCards(scheme.BusinessCardSearchOptions{})
+	assert.NoError(t, err)
+	assertResponse(t, []scheme.BusinessCard{*expected}, cards.BusinessCards)
+
+	card, err = client.GetBusinessCard("201")
+	assert.NoError(t, err)
+	assertResponse(t, expected, card)
+
+	assert.NoError(t, client.DeleteBusinessCard("201"))
+}
@@ -0,0 +1,70 @@
+package veryfi
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// ResponseMeta describes the HTTP response to a call.
+type ResponseMeta struct {
+	StatusCode int
+	Status     string
+	Header     http.Header
+
+	// RequestID identifies the request for Veryfi support. It is read from
+	// the HTTPOptions.RequestIDHeader header, and is empty if the response
+	// has none.
+	RequestID string
+
+	// Attempts is the number of times the request was sent, retries
+	// included.
+	Attempts int
+
+	// Latency is the time from sending the request until its last attempt
+	// got a response.
+	Latency time.Duration
+
+	// Body is the raw body of the response. It is nil for file downloads,
+	// whose body is streamed.
+	Body []byte
+}
+
+// WithResponse returns a copy of c that sets meta to the metadata of the
+// response to each call, whether the call succeeds or not. Calls that send
+// several requests, such as WaitDocumentBatch, describe the last one, and
+// DownloadDocuments, which sends them concurrently, records none. meta is
+// left as it is if no response arrives at all. The copy shares the options
+// and connections of c.
+//
+//	var meta veryfi.ResponseMeta
+//	doc, err := client.WithResponse(&meta).GetDocument(id, scheme.DocumentGetOptions{})
+func (c *Client) WithResponse(meta *ResponseMeta) API {
+	return c.withResponse(meta)
+}
+
+// withResponse is WithResponse returning a *Client.
+func (c *Client) withResponse(meta *ResponseMeta) *Client {
+	out := *c
+	out.response = meta
+	return &out
+}
+
+// record records resp, the response to a request sent at start, if c was
+// made by WithResponse.
+func (c *Client) record(resp *resty.Response, start time.Time) {
+	if c.response == nil || resp == nil || resp.RawResponse == nil {
+		return
+	}
+
+	*c.response = ResponseMeta{
+		StatusCode: resp.StatusCode(),
+		Status:     resp.Status(),
+		Header:     resp.Header(),
+		RequestID:  resp.Header().Get(c.options.HTTP.RequestIDHeader),
+		Attempts:   resp.Request.Attempt,
+		Latency:    time.Since(start),
+		Body:       resp.Body(),
+	}
+}
@@ -0,0 +1,59 @@
+package veryfi
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/veryfi/veryfi-go/v4/veryfi/scheme"
+	"github.com/veryfi/veryfi-go/v4/veryfi/test"
+)
+
+func TestUnitClientV8_WithResponse(t *testing.T) {
+	server := test.NewHTTPServer()
+	defer server.Close()
+
+	server.Handle("/api/v8/partner/documents/42/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Request-Id", "req-42")
+		w.Write([]byte(`{"id": 42}`))
+	})
+	server.Handle("/api/v8/partner/documents/43/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Request-Id", "req-43")
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"status": "fail", "error": "Document not found"}`))
+	})
+	client := newTestClient(t, server)
+
+	var meta ResponseMeta
+	doc, err := client.WithResponse(&meta).GetDocument("42", scheme.DocumentGetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, 42, doc.ID)
+	assert.Equal(t, http.StatusOK, meta.StatusCode)
+	assert.Equal(t, "200 OK", meta.Status)
+	assert.Equal(t, "application/json", meta.Header.Get("Content-Type"))
+	assert.Equal(t, "req-42", meta.RequestID)
+	assert.Equal(t, 1, meta.Attempts)
+	assert.Greater(t, meta.Latency, time.Duration(0))
+	assert.JSONEq(t, `{"id": 42}`, string(meta.Body))
+
+	// The metadata is set for failed calls too.
+	err = client.WithResponse(&meta).DeleteDocument("43")
+	assert.Error(t, err)
+	assert.Equal(t, http.StatusNotFound, meta.StatusCode)
+	assert.Equal(t, "req-43", meta.RequestID)
+	assert.JSONEq(t, `{"status": "fail", "error": "Document not found"}`, string(meta.Body))
+
+	// The client itself records nothing.
+	_, err = client.GetDocument("42", scheme.DocumentGetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, "req-43", meta.RequestID)
+
+	// The request ID header can be configured.
+	client.Config().HTTP.RequestIDHeader = "X-Trace"
+	_, err = client.WithResponse(&meta).GetDocument("42", scheme.DocumentGetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, "", meta.RequestID)
+}
@@ -0,0 +1,73 @@
+package veryfi
+
+import (
+	"fmt"
+
+	"github.com/veryfi/veryfi-go/v4/veryfi/scheme"
+)
+
+// ProcessCheckUpload returns the processed check. When opts.BackFilePath is
+// set, the back of the check is uploaded in the same request.
+func (c *Client) ProcessCheckUpload(opts scheme.CheckUploadOptions) (*scheme.Check, error) {
+	out := new(*scheme.Check)
+	encodedFile, err := Base64EncodeFile(opts.FilePath)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := scheme.CheckUploadBase64Options{
+		FileData:           encodedFile,
+		CheckSharedOptions: opts.CheckSharedOptions,
+	}
+	if opts.BackFilePath != "" {
+		payload.BackFileData, err = Base64EncodeFile(opts.BackFilePath)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if err := c.post(checkURI, payload, out); err != nil {
+		return nil, err
+	}
+
+	return *out, nil
+}
+
+// ProcessCheckURL returns the processed check using URL.
+func (c *Client) ProcessCheckURL(opts scheme.CheckURLOptions) (*scheme.Check, error) {
+	out := new(*scheme.Check)
+	if err := c.post(checkURI, opts, out); err != nil {
+		return nil, err
+	}
+
+	return *out, nil
+}
+
+// GetCheck returns a processed check.
+func (c *Client) GetCheck(documentID string, opts scheme.CheckGetOptions) (*scheme.Check, error) {
+	out := new(*scheme.Check)
+	if err := c.get(fmt.Sprintf("%s%s", checkURI, documentID), opts, out); err != nil {
+		return nil, err
+	}
+
+	return *out, nil
+}
+
+// SearchChecks returns a list of processed checks with matching queries.
+func (c *Client) SearchChecks(opts scheme.CheckSearchOptions) (*scheme.Checks, error) {
+	out := new(*scheme.Checks)
+	if err := c.get(checkURI, opts, out); err != nil {
+		return nil, err
+	}
+
+	return *out, nil
+}
+
+// DeleteCheck deletes a processed check.
+func (c *Client) DeleteCheck(documentID string) error {
+	err := c.rdelete(fmt.Sprintf("%s%s", checkURI, documentID))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,109 @@
+package veryfi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/veryfi/veryfi-go/v4/veryfi/scheme"
+)
+
+var checksEndpoint = endpoint{uri: "checks", id: "4662680", file: "check.json"}
+
+func expectedCheck() *scheme.Check {
+	return &scheme.Check{
+		ID:                4662680,
+		Created:           "2024-05-20 18:01:12",
+		Updated:           "2024-05-20 18:01:20",
+		ImgURL:            "https://scdn.veryfi.com/checks/front.png",
+		BackImgURL:        "https://scdn.veryfi.com/checks/back.png",
+		Amount:            scheme.MustParseAmount("1250"),
+		AmountText:        "One thousand two hundred fifty and 00/100",
+		BankName:          "First National Bank",
+		CheckNumber:       "1042",
+		CurrencyCode:      "USD",
+		Date:              "2024-05-17",
+		FractionalRouting: "30-4/1210",
+		IsSigned:          true,
+		Memo:              "Invoice 2024-118",
+		PayerAddress:      "77 Market St, San Francisco, CA 94103",
+		PayerName:         "Acme Supplies LLC",
+		ReceiverName:      "Blue Harbor Consulting",
+		MICR: scheme.CheckMICR{
+			Raw:           "⑈001042⑈ ⑆121000248⑆ 000009752⑈",
+			RoutingNumber: "121000248",
+			AccountNumber: "000009752",
+			SerialNumber:  "001042",
+			Score:         0.97,
+		},
+		Endorsement: scheme.CheckEndorsement{
+			IsEndorsed:            true,
+			IsSigned:              true,
+			MobileOrRemoteDeposit: true,
+			Text:                  "For mobile deposit only",
+		},
+		Stubs: []scheme.CheckStub{
+			{
+				Date:          "2024-05-01",
+				InvoiceNumber: "2024-118",
+				Description:   "April retainer",
+				Amount:        amountPtr("1250"),
+			},
+		},
+	}
+}
+
+func TestUnitClientV8_ProcessCheckUpload(t *testing.T) {
+	server, client, body := setUpEndpoints(t, checksEndpoint)
+	defer server.Close()
+
+	resp, err := client.ProcessCheckUpload(scheme.CheckUploadOptions{
+		FilePath:     "testdata/receipt_public.jpg",
+		BackFilePath: "testdata/receipt_public.jpg",
+	})
+	assert.NoError(t, err)
+	assertResponse(t, expectedCheck(), resp)
+	assert.NotEmpty(t, body["file_data"])
+	assert.Equal(t, body["file_data"], body["back_file_data"])
+
+	// The back of the check is optional.
+	_, err = client.ProcessCheckUpload(scheme.CheckUploadOptions{
+		FilePath: "testdata/receipt_public.jpg",
+	})
+	assert.NoError(t, err)
+	assert.NotContains(t, body, "back_file_data")
+
+	_, err = client.ProcessCheckUpload(scheme.CheckUploadOptions{
+		FilePath:     "testdata/receipt_public.jpg",
+		BackFilePath: "testdata/missing.jpg",
+	})
+	assert.Error(t, err)
+}
+
+func TestUnitClientV8_ProcessCheckURL(t *testing.T) {
+	server, client, body := setUpEndpoints(t, checksEndpoint)
+	defer server.Close()
+
+	resp, err := client.ProcessCheckURL(scheme.CheckURLOptions{
+		FileURL:     "https://example.com/front.png",
+		BackFileURL: "https://example.com/back.png",
+	})
+	assert.NoError(t, err)
+	assertResponse(t, expectedCheck(), resp)
+	assert.Equal(t, "https://example.com/front.png", body["file_url"])
+	assert.Equal(t, "https://example.com/back.png", body["back_file_url"])
+}
+
+func TestUnitClientV8_ManageChecks(t *testing.T) {
+	server, client, _ := setUpEndpoints(t, checksEndpoint)
+	defer server.Close()
+
+	checks, err := client.SearchChecks(scheme.CheckSearchOptions{})
+	assert.NoError(t, err)
+	assertResponse(t, []scheme.Check{*expectedCheck()}, checks.Checks)
+
+	resp, err := client.GetCheck("4662680", scheme.CheckGetOptions{})
+	assert.NoError(t, err)
+	assertResponse(t, expectedCheck(), resp)
+
+	assert.NoError(t, client.DeleteCheck("4662680"))
+}
@@ -0,0 +1,36 @@
+package veryfi
+
+import (
+	"github.com/veryfi/veryfi-go/v4/veryfi/scheme"
+)
+
+// ClassifyDocumentUpload returns the type of an uploaded file without
+// processing it.
+func (c *Client) ClassifyDocumentUpload(opts scheme.ClassifyUploadOptions) (*scheme.Classification, error) {
+	out := new(*scheme.Classification)
+	encodedFile, err := Base64EncodeFile(opts.FilePath)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := scheme.ClassifyUploadBase64Options{
+		FileData:              encodedFile,
+		ClassifySharedOptions: opts.ClassifySharedOptions,
+	}
+	if err := c.post(classifyURI, payload, out); err != nil {
+		return nil, err
+	}
+
+	return *out, nil
+}
+
+// ClassifyDocumentURL returns the type of a file using URL without processing
+// it.
+func (c *Client) ClassifyDocumentURL(opts scheme.ClassifyURLOptions) (*scheme.Classification, error) {
+	out := new(*scheme.Classification)
+	if err := c.post(classifyURI, opts, out); err != nil {
+		return nil, err
+	}
+
+	return *out, nil
+}
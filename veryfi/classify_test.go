@@ -0,0 +1,44 @@
+package veryfi
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/veryfi/veryfi-go/v4/veryfi/scheme"
+	"github.com/veryfi/veryfi-go/v4/veryfi/test"
+)
+
+func TestUnitClientV8_ClassifyDocument(t *testing.T) {
+	server := test.NewHTTPServer()
+	defer server.Close()
+
+	body := map[string]any{}
+	server.Handle("/api/v8/partner/classify/", func(w http.ResponseWriter, r *http.Request) {
+		captureBody(t, r, body)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id": 9, "document_type": {"value": "w9", "score": 0.96}}`))
+	})
+	client := newTestClient(t, server)
+	expected := &scheme.Classification{
+		ID:           9,
+		DocumentType: scheme.ClassifiedDocument{Value: scheme.DocumentTypeW9, Score: 0.96},
+	}
+
+	resp, err := client.ClassifyDocumentUpload(scheme.ClassifyUploadOptions{
+		FilePath: "testdata/receipt_public.jpg",
+		ClassifySharedOptions: scheme.ClassifySharedOptions{
+			DocumentTypes: []scheme.DocumentType{scheme.DocumentTypeW9, scheme.DocumentTypeCheck},
+		},
+	})
+	assert.NoError(t, err)
+	assertResponse(t, expected, resp)
+	assert.NotEmpty(t, body["file_data"])
+	assert.Equal(t, []any{"w9", "check"}, body["document_types"])
+
+	resp, err = client.ClassifyDocumentURL(scheme.ClassifyURLOptions{FileURL: "https://example.com/w9.pdf"})
+	assert.NoError(t, err)
+	assertResponse(t, expected, resp)
+	assert.Equal(t, "https://example.com/w9.pdf", body["file_url"])
+	assert.NotContains(t, body, "document_types")
+}
@@ -1,19 +1,15 @@
 package veryfi
 
 import (
-	"crypto/hmac"
-	"crypto/sha256"
 	"crypto/tls"
-	"encoding/base64"
 	"fmt"
-	"strconv"
-	"strings"
+	"net/http"
 	"time"
 
 	"github.com/pkg/errors"
 
 	"github.com/go-resty/resty/v2"
-	"github.com/veryfi/veryfi-go/v3/veryfi/scheme"
+	"github.com/veryfi/veryfi-go/v4/veryfi/scheme"
 )
 
 // Client implements a Veryfi API Client.
@@ -30,6 +26,9 @@ type Client struct {
 
 	// pkgVersion is the current SDK version.
 	pkgVersion string
+
+	// response receives the metadata of the responses, if not nil.
+	response *ResponseMeta
 }
 
 // NewClientV8 returns a new instance of a client for v8 API.
@@ -39,12 +38,16 @@ func NewClientV8(opts *Options) (*Client, error) {
 		return nil, errors.Wrap(err, "fail to create a client")
 	}
 
-	return &Client{
+	client := &Client{
 		options:    opts,
 		client:     c,
 		apiVersion: "v8",
 		pkgVersion: "2.1.2",
-	}, nil
+	}
+	// The host URL is set once, as requests may be sent concurrently.
+	c.SetHostURL(buildURL(opts.EnvironmentURL, "api", client.apiVersion))
+
+	return client, nil
 }
 
 // createClient setups a resty client with configured options.
@@ -58,7 +61,7 @@ func createClient(opts *Options) (*resty.Client, error) {
 	client := resty.New()
 	client = client.
 		SetTimeout(opts.HTTP.Timeout).
-		SetRetryCount(int(opts.HTTP.Retry.Count)).
+		SetRetryCount(int(*opts.HTTP.Retry.Count)).
 		SetRetryWaitTime(opts.HTTP.Retry.WaitTime).
 		SetRetryMaxWaitTime(opts.HTTP.Retry.MaxWaitTime).
 		OnAfterResponse(func(c *resty.Client, resp *resty.Response) error {
@@ -340,91 +343,112 @@ func (c *Client) GetDetailedDocument(documentID string, opts scheme.DocumentGetO
 }
 
 // request returns an authorized request to Veryfi API.
-func (c *Client) request(payload interface{}, okScheme interface{}, errScheme interface{}) *resty.Request {
-	timestamp := int(time.Now().Unix())
-	return c.setBaseURL().R().
+func (c *Client) request(method string, uri string, payload interface{}, okScheme interface{}, errScheme interface{}) (*resty.Request, error) {
+	creds, err := c.options.credentials()
+	if err != nil {
+		return nil, err
+	}
+
+	auth := c.options.Authenticator
+	if auth == nil {
+		auth = AutoAuthenticator{}
+	}
+	authHeaders, err := auth.Authenticate(&AuthRequest{
+		Method:      method,
+		Path:        uri,
+		Credentials: creds,
+		Params:      structToMap(payload),
+		Timestamp:   time.Now(),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "fail to authenticate request")
+	}
+
+	request := c.client.R().
 		SetHeaders(map[string]string{
-			"User-Agent":                 fmt.Sprintf("Go Veryfi-Go/%s", c.pkgVersion),
-			"Content-Type":               "application/json",
-			"Accept":                     "application/json",
-			"Client-Id":                  c.options.ClientID,
-			"Authorization":              authorizationHeader(c.options),
-			"X-Veryfi-Request-Timestamp": strconv.Itoa(timestamp),
-			"X-Veryfi-Request-Signature": c.generateSignature(payload, timestamp),
+			"User-Agent":   fmt.Sprintf("Go Veryfi-Go/%s", c.pkgVersion),
+			"Content-Type": "application/json",
+			"Accept":       "application/json",
 		}).
-		SetResult(okScheme).
+		SetHeaders(authHeaders).
 		SetError(errScheme)
-}
 
-// bearerKeyPrefix identifies new client-scoped API keys, which authenticate as a Bearer token.
-const bearerKeyPrefix = "vrfk_"
-
-// authorizationHeader builds the Authorization header value. Client-scoped keys (prefixed with
-// "vrfk_") are sent as `Bearer <key>` and need no username; all other keys use the legacy
-// `apikey <username>:<key>` format.
-func authorizationHeader(o *Options) string {
-	if strings.HasPrefix(o.APIKey, bearerKeyPrefix) {
-		return "Bearer " + o.APIKey
+	// With OnDrift set, responses are decoded by decode instead of resty.
+	if c.options.OnDrift == nil {
+		request.SetResult(okScheme)
 	}
-	return fmt.Sprintf("apikey %s:%s", o.Username, o.APIKey)
-}
 
-// setBaseURL returns a client that uses Veryfi's base URL.
-func (c *Client) setBaseURL() *resty.Client {
-	return c.client.SetHostURL(buildURL(c.options.EnvironmentURL, "api", c.apiVersion))
+	return request, nil
 }
 
 // post performs a POST request against Veryfi API.
 func (c *Client) post(uri string, body interface{}, okScheme interface{}) error {
 	errScheme := new(scheme.Error)
-	request := c.request(body, okScheme, errScheme).SetBody(body)
+	request, err := c.request(http.MethodPost, uri, body, okScheme, errScheme)
+	if err != nil {
+		return err
+	}
 
-	_, err := request.Post(uri)
+	start := time.Now()
+	resp, err := request.SetBody(body).Post(uri)
+	c.record(resp, start)
+	if err := check(err, errScheme); err != nil {
+		return err
+	}
 
-	return check(err, errScheme)
+	return c.decode(resp, okScheme)
 }
 
 // put performs a PUT request against Veryfi API.
 func (c *Client) put(uri string, body interface{}, okScheme interface{}) error {
 	errScheme := new(scheme.Error)
-	request := c.request(body, okScheme, errScheme).SetBody(body)
-	_, err := request.Put(uri)
+	request, err := c.request(http.MethodPut, uri, body, okScheme, errScheme)
+	if err != nil {
+		return err
+	}
+	start := time.Now()
+	resp, err := request.SetBody(body).Put(uri)
+	c.record(resp, start)
+	if err := check(err, errScheme); err != nil {
+		return err
+	}
 
-	return check(err, errScheme)
+	return c.decode(resp, okScheme)
 }
 
 // get performs a GET request against Veryfi API.
 func (c *Client) get(uri string, queryParams interface{}, okScheme interface{}) error {
 	errScheme := new(scheme.Error)
-	request := c.request(queryParams, okScheme, errScheme)
+	request, err := c.request(http.MethodGet, uri, queryParams, okScheme, errScheme)
+	if err != nil {
+		return err
+	}
 	if queryParams != nil {
 		request.SetQueryParams(structToMap(queryParams))
 	}
 
-	_, err := request.Get(uri)
+	start := time.Now()
+	resp, err := request.Get(uri)
+	c.record(resp, start)
+	if err := check(err, errScheme); err != nil {
+		return err
+	}
 
-	return check(err, errScheme)
+	return c.decode(resp, okScheme)
 }
 
 // rdelete performs a DELETE request against Veryfi API.
 func (c *Client) rdelete(uri string) error {
 	errScheme := new(scheme.Error)
-	request := c.request(struct{}{}, map[string]string{}, errScheme)
-	_, err := request.Delete(uri)
-
-	return check(err, errScheme)
-}
-
-// generateSignature for a given request.
-func (c *Client) generateSignature(s interface{}, timestamp int) string {
-	p := []string{fmt.Sprintf("timestamp:%v", timestamp)}
-	for k, v := range structToMap(s) {
-		p = append(p, fmt.Sprintf("%v:%v", k, v))
+	request, err := c.request(http.MethodDelete, uri, struct{}{}, map[string]string{}, errScheme)
+	if err != nil {
+		return err
 	}
+	start := time.Now()
+	resp, err := request.Delete(uri)
+	c.record(resp, start)
 
-	h := hmac.New(sha256.New, []byte(c.options.ClientSecret))
-	h.Write([]byte(strings.Join(p, ",")))
-	return base64.StdEncoding.EncodeToString(h.Sum(nil))
+	return check(err, errScheme)
 }
 
 // check validates returned response from Veryfi.
@@ -1,21 +1,108 @@
 package veryfi
 
 import (
+	"crypto/tls"
+	"net/http"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/veryfi/veryfi-go/v4/veryfi/test"
 )
 
+// authorizationHeader returns the Authorization header AutoAuthenticator sets.
+func authorizationHeader(t *testing.T, c Credentials) string {
+	headers, err := AutoAuthenticator{}.Authenticate(&AuthRequest{Credentials: c, Timestamp: time.Now()})
+	assert.NoError(t, err)
+	return headers["Authorization"]
+}
+
 func TestAuthorizationHeader(t *testing.T) {
 	// Legacy keys use the `apikey <username>:<key>` format.
-	legacy := authorizationHeader(&Options{Username: "user", APIKey: "legacykey"})
+	legacy := authorizationHeader(t, Credentials{Username: "user", APIKey: "legacykey"})
 	assert.Equal(t, "apikey user:legacykey", legacy)
 
 	// Client-scoped keys (vrfk_ prefix) authenticate as a Bearer token, no username needed.
-	bearer := authorizationHeader(&Options{APIKey: "vrfk_abc123"})
+	bearer := authorizationHeader(t, Credentials{APIKey: "vrfk_abc123"})
 	assert.Equal(t, "Bearer vrfk_abc123", bearer)
 
 	// A vrfk_ key still uses Bearer even if a username happens to be set (it's ignored).
-	bearerWithUser := authorizationHeader(&Options{Username: "user", APIKey: "vrfk_xyz"})
+	bearerWithUser := authorizationHeader(t, Credentials{Username: "user", APIKey: "vrfk_xyz"})
 	assert.Equal(t, "Bearer vrfk_xyz", bearerWithUser)
 }
+
+func TestUnitAuthenticators_Signature(t *testing.T) {
+	r := &AuthRequest{
+		Credentials: Credentials{ClientID: "id", ClientSecret: "secret", Username: "user", APIKey: "key"},
+		Params:      map[string]string{"q": "foo"},
+		Timestamp:   time.Unix(1700000000, 0),
+	}
+
+	// Legacy requests are signed with HMAC-SHA256 keyed by the client secret.
+	headers, err := LegacyAuthenticator{}.Authenticate(r)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"Client-Id":                  "id",
+		"Authorization":              "apikey user:key",
+		"X-Veryfi-Request-Timestamp": "1700000000",
+		"X-Veryfi-Request-Signature": "A/I//WOUUMtv22qhzCmOubFE7cjQi6v9UuSqjOvjzqM=",
+	}, headers)
+
+	// Bearer requests are not signed.
+	r.Credentials.APIKey = "vrfk_key"
+	headers, err = AutoAuthenticator{}.Authenticate(r)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"Client-Id": "id", "Authorization": "Bearer vrfk_key"}, headers)
+}
+
+// kmsSigner stands in for a signer backed by an external KMS.
+type kmsSigner struct {
+	messages []string
+}
+
+func (s *kmsSigner) Sign(creds Credentials, message []byte) (string, error) {
+	s.messages = append(s.messages, string(message))
+	return "kms-signature", nil
+}
+
+func TestUnitClientV8_CustomAuthenticator(t *testing.T) {
+	server := test.NewHTTPServer()
+	defer server.Close()
+
+	var got http.Header
+	server.Handle("/api/v8/partner/tags/", func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header.Clone()
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"tags": []}`))
+	})
+
+	signer := &kmsSigner{}
+	client, err := NewClientV8(&Options{
+		EnvironmentURL: server.URL,
+		ClientID:       "id",
+		Username:       "user",
+		APIKey:         "key",
+		Authenticator:  LegacyAuthenticator{Signer: signer},
+	})
+	assert.NoError(t, err)
+	client.SetTLSConfig(&tls.Config{InsecureSkipVerify: true})
+
+	_, err = client.GetGlobalTags()
+	assert.NoError(t, err)
+	assert.Equal(t, "apikey user:key", got.Get("Authorization"))
+	assert.Equal(t, "kms-signature", got.Get("X-Veryfi-Request-Signature"))
+	assert.Len(t, signer.messages, 1)
+	assert.Contains(t, signer.messages[0], "timestamp:")
+
+	// A fully custom scheme only needs to return its headers.
+	var path string
+	client.Config().Authenticator = AuthenticatorFunc(func(r *AuthRequest) (map[string]string, error) {
+		path = r.Method + " " + r.Path
+		return map[string]string{"Authorization": "Custom token"}, nil
+	})
+	_, err = client.GetGlobalTags()
+	assert.NoError(t, err)
+	assert.Equal(t, "Custom token", got.Get("Authorization"))
+	assert.Empty(t, got.Get("X-Veryfi-Request-Signature"))
+	assert.Equal(t, "GET /partner/tags/", path)
+}
@@ -2,20 +2,28 @@ package veryfi
 
 import (
 	"crypto/tls"
+	"encoding/json"
 	"fmt"
+	"net/http"
 	"os"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
-	"github.com/veryfi/veryfi-go/v3/veryfi/scheme"
-	"github.com/veryfi/veryfi-go/v3/veryfi/test"
+	"github.com/veryfi/veryfi-go/v4/veryfi/scheme"
+	"github.com/veryfi/veryfi-go/v4/veryfi/scheme/schemetest"
+	"github.com/veryfi/veryfi-go/v4/veryfi/test"
 )
 
 func float64Ptr(v float64) *float64 {
 	return &v
 }
 
+func amountPtr(v string) *scheme.Amount {
+	a := scheme.MustParseAmount(v)
+	return &a
+}
+
 func stringPtr(v string) *string {
 	return &v
 }
@@ -24,6 +32,17 @@ func boolPtr(v bool) *bool {
 	return &v
 }
 
+func uintPtr(v uint) *uint {
+	return &v
+}
+
+// assertResponse asserts that a decoded response equals expected, leaving out
+// the unknown fields and raw JSON it keeps.
+func assertResponse(t *testing.T, expected, actual any) {
+	t.Helper()
+	assert.EqualValues(t, expected, schemetest.WithoutUnknown(actual))
+}
+
 func setUp(t *testing.T, useDetailedReceipt bool) (test.HTTPServer, *Client, string, interface{}) {
 	server := test.NewHTTPServer()
 	assert.NotNil(t, server)
@@ -55,6 +74,114 @@ func setUp(t *testing.T, useDetailedReceipt bool) (test.HTTPServer, *Client, str
 			Status:          "processed",
 			Tags:            []scheme.Tag{},
 
+			AccountingEntryType: "debit",
+			Balance: &scheme.DetailedFloatField{
+				Score: float64Ptr(0.42),
+			},
+			Cashback: &scheme.DetailedFloatField{
+				Score: float64Ptr(0.99),
+			},
+			CountryCode: &scheme.DetailedField{
+				Value: stringPtr("US"),
+				Score: float64Ptr(0.97),
+			},
+			DefaultCategory: &scheme.DetailedField{
+				Value: stringPtr("Job Supplies"),
+				Score: float64Ptr(0.59),
+			},
+			DeliveryNoteNumber: &scheme.DetailedField{
+				Score: float64Ptr(1.0),
+			},
+			DocumentReferenceNumber: &scheme.DetailedField{
+				Value:    stringPtr("0329-6224-7823-2205-2403"),
+				Score:    float64Ptr(1.0),
+				OCRScore: float64Ptr(0.99),
+				BoundingBox: []float64{
+					0, 0.2944, 0.7461, 0.8286, 0.7627,
+				},
+				BoundingRegion: []float64{
+					0.2944, 0.7461, 0.8286, 0.7461, 0.8286, 0.7627, 0.2944, 0.7627,
+				},
+			},
+			DocumentTitle: &scheme.DetailedField{
+				Score: float64Ptr(1.0),
+			},
+			DuplicateOf:  297133590,
+			ExchangeRate: 1.0,
+			FinalBalance: &scheme.DetailedFloatField{
+				Score: float64Ptr(0.71),
+			},
+			GuestCount: &scheme.DetailedField{
+				Score: float64Ptr(1.0),
+			},
+			Incoterms: &scheme.DetailedField{
+				Score: float64Ptr(1.0),
+			},
+			IsDocument: true,
+			IsMoneyIn: &scheme.DetailedBoolField{
+				Value: boolPtr(false),
+				Score: float64Ptr(0.89),
+			},
+			IsTransaction: &scheme.DetailedBoolField{
+				Value: boolPtr(false),
+				Score: float64Ptr(1.0),
+			},
+			LicensePlateNumber: &scheme.DetailedField{
+				Score: float64Ptr(1.0),
+			},
+			Model:        "2.61.0",
+			PDFURL:       "https://scdn.veryfi.com/receipts/img.pdf",
+			PaymentLinks: []string{},
+			PreviousBalance: &scheme.DetailedFloatField{
+				Score: float64Ptr(0.96),
+			},
+			ServerName: &scheme.DetailedField{
+				Score: float64Ptr(1.0),
+			},
+			Shipping: &scheme.DetailedFloatField{
+				Score: float64Ptr(1.0),
+			},
+			TotalQuantity: &scheme.DetailedFloatField{
+				Score: float64Ptr(1.0),
+			},
+			TrackingNumbers: []*scheme.DetailedField{
+				{
+					Score: float64Ptr(1.0),
+				},
+			},
+			VINNumber: &scheme.DetailedField{
+				Score: float64Ptr(1.0),
+			},
+			VendingPerson: &scheme.DetailedField{
+				Score: float64Ptr(1.0),
+			},
+			VendingPersonNumber: &scheme.DetailedField{
+				Score: float64Ptr(0.97),
+			},
+			Vendors: []*scheme.DetailedField{
+				{
+					Value:    stringPtr("Walgreens"),
+					Score:    float64Ptr(1.0),
+					OCRScore: float64Ptr(0.98),
+					BoundingBox: []float64{
+						0, 0.0337, 0.0187, 0.8896, 0.0629,
+					},
+					BoundingRegion: []float64{
+						0.0337, 0.0187, 0.8896, 0.0187, 0.8896, 0.0629, 0.0337, 0.0629,
+					},
+				},
+				{
+					Value: stringPtr("Walgreens"),
+					Score: float64Ptr(0.98),
+				},
+			},
+			Warnings: []string{},
+			Weights: []*scheme.DetailedField{
+				{
+					Score: float64Ptr(0.99),
+				},
+			},
+
 			// Detailed fields with scores
 			AccountNumber: &scheme.DetailedField{
 				Value:          stringPtr("0053"),
@@ -413,10 +540,10 @@ func setUp(t *testing.T, useDetailedReceipt bool) (test.HTTPServer, *Client, str
 					Category:              "Job Supplies",
 					ID:                    1346628550,
 					Order:                 0,
-					Price:                 9.99,
+					Price:                 scheme.MustParseAmount("9.99"),
 					Quantity:              1.0,
-					Discount:              1.2,
-					Total:                 8.79,
+					Discount:              scheme.MustParseAmount("1.2"),
+					Total:                 scheme.MustParseAmount("8.79"),
 					SKU:                   "61126943157",
 					Type:                  "food",
 					Tags:                  []string{},
@@ -430,7 +557,7 @@ func setUp(t *testing.T, useDetailedReceipt bool) (test.HTTPServer, *Client, str
 					ID:                    1346628551,
 					Order:                 1,
 					Quantity:              1.0,
-					Total:                 0.3,
+					Total:                 scheme.MustParseAmount("0.3"),
 					SKU:                   "00000007211",
 					Type:                  "fee",
 					Tags:                  []string{},
@@ -567,10 +694,38 @@ func setUp(t *testing.T, useDetailedReceipt bool) (test.HTTPServer, *Client, str
 						BoundingRegion: nil,
 						Rotation:       0,
 					},
-					ID:    1346628550,
-					Order: 0,
-					Type:  stringPtr("food"),
-					Tags:  []string{},
+					CountryOfOrigin: &scheme.DetailedField{},
+					DiscountPrice:   &scheme.DetailedFloatField{},
+					DiscountRate:    &scheme.DetailedFloatField{},
+					EndDate:         &scheme.DetailedField{},
+					FullDescription: &scheme.DetailedField{
+						Value:    stringPtr("RED BULL ENRGY DRNK CNS 8.4OZ 6PK"),
+						Score:    float64Ptr(0.78),
+						OCRScore: float64Ptr(0.98),
+						BoundingBox: []float64{
+							0, 0.0326, 0.1411, 0.7573, 0.1592,
+						},
+						BoundingRegion: []float64{
+							0.0325, 0.146, 0.7573, 0.1401, 0.7574, 0.1541, 0.0326, 0.16,
+						},
+					},
+					GrossTotal:   &scheme.DetailedFloatField{},
+					HSN:          &scheme.DetailedField{},
+					Lot:          &scheme.DetailedField{},
+					Manufacturer: &scheme.DetailedField{},
+					NetTotal:     &scheme.DetailedFloatField{},
+					NormalizedDescription: &scheme.DetailedField{
+						Value: stringPtr("RED BULL ENERGY DRINK CNS 8.4OZ 6PK"),
+					},
+					StartDate: &scheme.DetailedField{},
+					Subtotal:  &scheme.DetailedFloatField{},
+					TaxCode:   &scheme.DetailedField{},
+					Text:      "RED BULL ENRGY DRNK CNS 8.4OZ 6PK\n61126943157\tA\t8.79 SALE\nREGULAR PRICE 9.99\nMYWALGREENS SAVINGS 1.20\nRETURN VALUE 8.79",
+					Weight:    &scheme.DetailedField{},
+					ID:        1346628550,
+					Order:     0,
+					Type:      stringPtr("food"),
+					Tags:      []string{},
 				},
 				{
 					Category: &scheme.DetailedField{
@@ -692,10 +847,36 @@ func setUp(t *testing.T, useDetailedReceipt bool) (test.HTTPServer, *Client, str
 						},
 						Rotation: 0,
 					},
-					ID:    1346628551,
-					Order: 1,
-					Type:  stringPtr("fee"),
-					Tags:  []string{},
+					CountryOfOrigin: &scheme.DetailedField{},
+					DiscountPrice:   &scheme.DetailedFloatField{},
+					DiscountRate:    &scheme.DetailedFloatField{},
+					EndDate:         &scheme.DetailedField{},
+					FullDescription: &scheme.DetailedField{
+						Value:    stringPtr("CA REDMP VAL"),
+						Score:    float64Ptr(0.79),
+						OCRScore: float64Ptr(0.98),
+						BoundingBox: []float64{
+							0, 0.0337, 0.2073, 0.3008, 0.2206,
+						},
+						BoundingRegion: []float64{
+							0.0336, 0.2082, 0.3008, 0.2069, 0.3008, 0.2196, 0.0337, 0.2208,
+						},
+					},
+					GrossTotal:            &scheme.DetailedFloatField{},
+					HSN:                   &scheme.DetailedField{},
+					Lot:                   &scheme.DetailedField{},
+					Manufacturer:          &scheme.DetailedField{},
+					NetTotal:              &scheme.DetailedFloatField{},
+					NormalizedDescription: &scheme.DetailedField{},
+					StartDate:             &scheme.DetailedField{},
+					Subtotal:              &scheme.DetailedFloatField{},
+					TaxCode:               &scheme.DetailedField{},
+					Text:                  "CA REDMP VAL\n00000007211\t\t0.30",
+					Weight:                &scheme.DetailedField{},
+					ID:                    1346628551,
+					Order:                 1,
+					Type:                  stringPtr("fee"),
+					Tags:                  []string{},
 				},
 			},
 
@@ -705,7 +886,7 @@ func setUp(t *testing.T, useDetailedReceipt bool) (test.HTTPServer, *Client, str
 					Order: 0,
 					Code:  "A",
 					Rate:  9.625,
-					Total: 1.93,
+					Total: scheme.MustParseAmount("1.93"),
 				},
 			},
 
@@ -869,9 +1050,9 @@ func setUp(t *testing.T, useDetailedReceipt bool) (test.HTTPServer, *Client, str
 				{
 					Description: "98 Meat Pty Xchz",
 					ID:          67185481,
-					Price:       0.0,
+					Price:       scheme.Amount{},
 					Quantity:    1.0,
-					Total:       90.85,
+					Total:       scheme.MustParseAmount("90.85"),
 					Type:        "food",
 				},
 			},
@@ -883,14 +1064,14 @@ func setUp(t *testing.T, useDetailedReceipt bool) (test.HTTPServer, *Client, str
 				Type:        "cash",
 			},
 			ReferenceNumber: "VBIJG-6934",
-			Tax:             97.66,
+			Tax:             scheme.MustParseAmount("97.66"),
 			TaxLines: []scheme.TaxLine{
 				{
 					Rate:  7.5,
-					Total: 97.66,
+					Total: scheme.MustParseAmount("97.66"),
 				},
 			},
-			Total:   97.66,
+			Total:   scheme.MustParseAmount("97.66"),
 			Updated: "2021-06-22 20:11:11",
 			Vendor: scheme.Vendor{
 				Name:    "In-N-Out Burger",
@@ -947,16 +1128,26 @@ func TestUnitNewClientV8_Config(t *testing.T) {
 		HTTP: HTTPOptions{
 			Timeout: timeout,
 			Retry: RetryOptions{
-				Count:       3,
+				Count:       uintPtr(3),
 				WaitTime:    waitTime,
 				MaxWaitTime: maxWaitTime,
 			},
+			RequestIDHeader: "X-Request-Id",
 		},
 	}
 
 	resp := client.Config()
 	assert.NotNil(t, resp)
 	assert.EqualValues(t, expected, resp)
+
+	// A retry count of 0 turns retries off.
+	client, err = NewClientV8(&Options{
+		ClientID: "testClientID",
+		APIKey:   "vrfk_testAPIKey",
+		HTTP:     HTTPOptions{Retry: RetryOptions{Count: uintPtr(0)}},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 0, client.client.RetryCount)
 }
 
 func TestUnitClientV8_GetDocument(t *testing.T) {
@@ -966,7 +1157,7 @@ func TestUnitClientV8_GetDocument(t *testing.T) {
 	resp, err := client.GetDocument("36966934", scheme.DocumentGetOptions{})
 	assert.NotNil(t, resp)
 	assert.NoError(t, err)
-	assert.EqualValues(t, expected, resp)
+	assertResponse(t, expected, resp)
 }
 
 func TestUnitClientV8_GetDetailedDocument(t *testing.T) {
@@ -977,7 +1168,7 @@ func TestUnitClientV8_GetDetailedDocument(t *testing.T) {
 
 	assert.NotNil(t, resp)
 	assert.NoError(t, err)
-	assert.EqualValues(t, expected, resp)
+	assertResponse(t, expected, resp)
 }
 
 func TestUnitClientV8_ProcessDocumentUpload(t *testing.T) {
@@ -989,7 +1180,7 @@ func TestUnitClientV8_ProcessDocumentUpload(t *testing.T) {
 	})
 	assert.NotNil(t, resp)
 	assert.NoError(t, err)
-	assert.EqualValues(t, expected, resp)
+	assertResponse(t, expected, resp)
 }
 
 func TestUnitClientV8_ProcessDocumentURL(t *testing.T) {
@@ -1001,7 +1192,7 @@ func TestUnitClientV8_ProcessDocumentURL(t *testing.T) {
 	})
 	assert.NotNil(t, resp)
 	assert.NoError(t, err)
-	assert.EqualValues(t, expected, resp)
+	assertResponse(t, expected, resp)
 }
 
 func TestUnitClientV8_ProcessDetailedDocumentUpload(t *testing.T) {
@@ -1013,7 +1204,7 @@ func TestUnitClientV8_ProcessDetailedDocumentUpload(t *testing.T) {
 	})
 	assert.NotNil(t, resp)
 	assert.NoError(t, err)
-	assert.EqualValues(t, expected, resp)
+	assertResponse(t, expected, resp)
 }
 
 func TestUnitClientV8_ProcessDetailedDocumentURL(t *testing.T) {
@@ -1025,5 +1216,71 @@ func TestUnitClientV8_ProcessDetailedDocumentURL(t *testing.T) {
 	})
 	assert.NotNil(t, resp)
 	assert.NoError(t, err)
-	assert.EqualValues(t, expected, resp)
+	assertResponse(t, expected, resp)
+}
+
+// newTestClient returns a Client talking to server.
+func newTestClient(t *testing.T, server test.HTTPServer) *Client {
+	client, err := NewClientV8(&Options{
+		EnvironmentURL: server.URL,
+		ClientID:       "testClientID",
+		Username:       "testUsername",
+		APIKey:         "testAPIKey",
+	})
+	assert.NoError(t, err)
+	client.SetTLSConfig(&tls.Config{InsecureSkipVerify: true})
+
+	return client
+}
+
+// endpoint is a document endpoint served by setUpEndpoints.
+type endpoint struct {
+	// uri is the path of the endpoint, e.g. "checks".
+	uri string
+
+	// id is the ID of the served document.
+	id string
+
+	// file is the testdata file holding the served document.
+	file string
+}
+
+// setUpEndpoints returns a server for the endpoints: a POST to an endpoint
+// processes its document, a GET searches for it, and a GET or a DELETE on the
+// document ID gets or deletes it. The returned map holds the body of the last
+// POST.
+func setUpEndpoints(t *testing.T, endpoints ...endpoint) (test.HTTPServer, *Client, map[string]any) {
+	server := test.NewHTTPServer()
+
+	body := map[string]any{}
+	for _, e := range endpoints {
+		mockResp, err := os.ReadFile("testdata/" + e.file)
+		assert.NoError(t, err)
+
+		server.Handle("/api/v8/partner/"+e.uri+"/", func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			if r.Method == http.MethodPost {
+				captureBody(t, r, body)
+				w.Write(mockResp)
+				return
+			}
+			w.Write([]byte(`{"documents": [` + string(mockResp) + `], "meta": {"total_pages": 1, "total_results": 1}}`))
+		})
+		server.Handle("/api/v8/partner/"+e.uri+"/"+e.id, func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			if r.Method == http.MethodDelete {
+				w.Write([]byte(`{"status": "ok"}`))
+				return
+			}
+			w.Write(mockResp)
+		})
+	}
+
+	return server, newTestClient(t, server), body
+}
+
+// captureBody decodes the JSON body of r into body, replacing what it held.
+func captureBody(t *testing.T, r *http.Request, body map[string]any) {
+	clear(body)
+	assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
 }
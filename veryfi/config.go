@@ -1,6 +1,10 @@
 package veryfi
 
 import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/creasty/defaults"
@@ -24,25 +28,46 @@ type Options struct {
 	// APIKey provided by Veryfi.
 	APIKey string `default:"-"`
 
+	// Credentials, when set, is consulted on every request so that secrets
+	// can rotate. Fields it leaves empty fall back to ClientID, ClientSecret,
+	// Username and APIKey.
+	Credentials CredentialsProvider
+
+	// Authenticator sets the authentication headers of each request. Defaults
+	// to AutoAuthenticator, which picks the scheme from the API key.
+	Authenticator Authenticator
+
 	// HTTP specifies the options for http protocol, used by a http client.
 	HTTP HTTPOptions
+
+	// OnDrift, when set, turns on strict decoding, meant for staging: every
+	// response is compared with the type it is decoded into, and OnDrift is
+	// called with the differences. Values of the wrong type are left zero
+	// instead of failing the call.
+	OnDrift func(DriftReport)
 }
 
 // HTTPOptions is the config options for http protocol,
 type HTTPOptions struct {
-	// Timeout specifies a time limit for a http request.
+	// Timeout specifies a time limit for a http request. It defaults to 120s
+	// when zero.
 	Timeout time.Duration `default:"120s"`
 
 	// Retry specifies the options for retry mechanism.
 	Retry RetryOptions
+
+	// RequestIDHeader is the response header holding the ID of a request,
+	// see ResponseMeta.RequestID.
+	RequestIDHeader string `default:"X-Request-Id"`
 }
 
 // RetryOptions is the config options for backoff retry mechanism. Its strategy
 // is to increase retry intervals after each failed attempt, until some maximum
 // value.
 type RetryOptions struct {
-	// Count specifies the number of retry attempts. Zero value means no retry.
-	Count uint `default:"3"`
+	// Count specifies the number of retry attempts. It defaults to 3 when
+	// nil; point it to 0 to turn retries off.
+	Count *uint `default:"3"`
 
 	// WaitTime specifies the wait time before retrying request. It is
 	// increased after each attempt.
@@ -65,3 +90,94 @@ func setDefaults(opts *Options) error {
 
 	return nil
 }
+
+// ValidationError lists every problem found by Options.Validate.
+type ValidationError struct {
+	// Problems describes each problem in a short sentence.
+	Problems []string
+}
+
+// Error implements the error interface.
+func (e *ValidationError) Error() string {
+	return "invalid options: " + strings.Join(e.Problems, "; ")
+}
+
+// Validate reports missing credentials, including those of the Credentials
+// provider if one is set, a malformed EnvironmentURL and
+// nonsensical HTTP settings. The HTTP timeout must be positive, so set it or
+// start from LoadOptions; zero retry wait times are accepted since they are
+// replaced by defaults when a client is created. It returns a
+// *ValidationError listing every problem, or nil.
+func (o *Options) Validate() error {
+	if o == nil {
+		return &ValidationError{Problems: []string{"options can not be nil"}}
+	}
+
+	problems := []string{}
+	creds, err := o.credentials()
+	if err != nil {
+		problems = append(problems, err.Error())
+	} else {
+		if creds.ClientID == "" {
+			problems = append(problems, "client ID is missing")
+		}
+		if creds.APIKey == "" {
+			problems = append(problems, "API key is missing")
+		} else if o.Authenticator == nil && !strings.HasPrefix(creds.APIKey, bearerKeyPrefix) && creds.Username == "" {
+			problems = append(problems, "username is missing, it is required by API keys without the "+bearerKeyPrefix+" prefix")
+		}
+	}
+
+	if o.EnvironmentURL != "" {
+		if problem := validateHost(o.EnvironmentURL); problem != "" {
+			problems = append(problems, problem)
+		}
+	}
+
+	if o.HTTP.Timeout <= 0 {
+		problems = append(problems, "HTTP timeout must be positive")
+	}
+	retry := o.HTTP.Retry
+	if retry.WaitTime < 0 {
+		problems = append(problems, "retry wait time can not be negative")
+	}
+	if retry.MaxWaitTime < 0 {
+		problems = append(problems, "retry max wait time can not be negative")
+	}
+	if retry.WaitTime > 0 && retry.MaxWaitTime > 0 && retry.WaitTime > retry.MaxWaitTime {
+		problems = append(problems, fmt.Sprintf(
+			"retry wait time %s exceeds retry max wait time %s", retry.WaitTime, retry.MaxWaitTime,
+		))
+	}
+
+	if len(problems) > 0 {
+		return &ValidationError{Problems: problems}
+	}
+
+	return nil
+}
+
+// validateHost checks that an EnvironmentURL is a bare `host[:port]`, and
+// returns a description of the problem if it is not.
+func validateHost(host string) string {
+	if strings.Contains(host, "://") {
+		return fmt.Sprintf("environment URL %q must not include a scheme", host)
+	}
+	if strings.ContainsAny(host, "/?#") {
+		return fmt.Sprintf("environment URL %q must not include a path or trailing `/`", host)
+	}
+
+	u, err := url.Parse("https://" + host)
+	if err != nil || u.Hostname() == "" || u.User != nil {
+		return fmt.Sprintf("environment URL %q is not a valid host", host)
+	}
+	if port := u.Port(); port != "" {
+		if n, err := strconv.Atoi(port); err != nil || n < 1 || n > 65535 {
+			return fmt.Sprintf("environment URL %q has an invalid port", host)
+		}
+	} else if strings.HasSuffix(host, ":") {
+		return fmt.Sprintf("environment URL %q has an invalid port", host)
+	}
+
+	return ""
+}
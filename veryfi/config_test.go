@@ -0,0 +1,87 @@
+package veryfi
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitOptionsValidate(t *testing.T) {
+	http := HTTPOptions{Timeout: time.Second}
+	tests := []struct {
+		name     string
+		in       *Options
+		expected []string
+	}{
+		{
+			name: "client-scoped key",
+			in:   &Options{ClientID: "id", APIKey: "vrfk_key", HTTP: http},
+		},
+		{
+			name: "legacy key with port",
+			in:   &Options{ClientID: "id", Username: "user", APIKey: "key", EnvironmentURL: "localhost:8443", HTTP: http},
+		},
+		{
+			name:     "missing credentials",
+			in:       &Options{},
+			expected: []string{"client ID is missing", "API key is missing", "HTTP timeout must be positive"},
+		},
+		{
+			name:     "legacy key without username",
+			in:       &Options{ClientID: "id", APIKey: "key", HTTP: http},
+			expected: []string{"username is missing, it is required by API keys without the vrfk_ prefix"},
+		},
+		{
+			name:     "scheme",
+			in:       &Options{ClientID: "id", APIKey: "vrfk_key", EnvironmentURL: "https://api.veryfi.com", HTTP: http},
+			expected: []string{`environment URL "https://api.veryfi.com" must not include a scheme`},
+		},
+		{
+			name:     "trailing slash",
+			in:       &Options{ClientID: "id", APIKey: "vrfk_key", EnvironmentURL: "api.veryfi.com/", HTTP: http},
+			expected: []string{"environment URL \"api.veryfi.com/\" must not include a path or trailing `/`"},
+		},
+		{
+			name:     "bad port",
+			in:       &Options{ClientID: "id", APIKey: "vrfk_key", EnvironmentURL: "api.veryfi.com:99999", HTTP: http},
+			expected: []string{`environment URL "api.veryfi.com:99999" has an invalid port`},
+		},
+		{
+			name: "retry settings",
+			in: &Options{ClientID: "id", APIKey: "vrfk_key", HTTP: HTTPOptions{
+				Timeout: time.Second,
+				Retry:   RetryOptions{Count: uintPtr(3), WaitTime: 10 * time.Second, MaxWaitTime: 5 * time.Second},
+			}},
+			expected: []string{"retry wait time 10s exceeds retry max wait time 5s"},
+		},
+		{
+			name:     "zero timeout",
+			in:       &Options{ClientID: "id", APIKey: "vrfk_key", HTTP: HTTPOptions{Retry: RetryOptions{Count: uintPtr(0)}}},
+			expected: []string{"HTTP timeout must be positive"},
+		},
+		{
+			name: "negative durations",
+			in: &Options{ClientID: "id", APIKey: "vrfk_key", HTTP: HTTPOptions{
+				Timeout: -1,
+				Retry:   RetryOptions{WaitTime: -1, MaxWaitTime: -1},
+			}},
+			expected: []string{
+				"HTTP timeout must be positive",
+				"retry wait time can not be negative",
+				"retry max wait time can not be negative",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		err := tt.in.Validate()
+		if tt.expected == nil {
+			assert.NoError(t, err, tt.name)
+			continue
+		}
+		if assert.IsType(t, &ValidationError{}, err, tt.name) {
+			assert.Equal(t, tt.expected, err.(*ValidationError).Problems, tt.name)
+		}
+	}
+}
@@ -0,0 +1,291 @@
+package veryfi
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v3"
+)
+
+// Credentials holds the secrets used to authenticate a request.
+type Credentials struct {
+	// ClientID provided by Veryfi.
+	ClientID string
+
+	// ClientSecret provided by Veryfi.
+	ClientSecret string
+
+	// Username provided by Veryfi.
+	Username string
+
+	// APIKey provided by Veryfi.
+	APIKey string
+}
+
+// CredentialsProvider supplies the credentials of each request, so that
+// secrets can rotate without rebuilding the client. Implementations must be
+// safe for concurrent use.
+type CredentialsProvider interface {
+	// Credentials returns the current credentials. Empty fields fall back to
+	// the static credentials of Options.
+	Credentials() (Credentials, error)
+}
+
+// CredentialsProviderFunc adapts a function, e.g. one that reads from a
+// vault, to a CredentialsProvider.
+type CredentialsProviderFunc func() (Credentials, error)
+
+// Credentials calls f.
+func (f CredentialsProviderFunc) Credentials() (Credentials, error) {
+	return f()
+}
+
+// credentials returns the credentials of a request: those of the provider, if
+// any, with empty fields filled from the static options.
+func (o *Options) credentials() (Credentials, error) {
+	static := Credentials{
+		ClientID:     o.ClientID,
+		ClientSecret: o.ClientSecret,
+		Username:     o.Username,
+		APIKey:       o.APIKey,
+	}
+	if o.Credentials == nil {
+		return static, nil
+	}
+
+	c, err := o.Credentials.Credentials()
+	if err != nil {
+		return Credentials{}, errors.Wrap(err, "fail to get credentials")
+	}
+	setString(&static.ClientID, c.ClientID)
+	setString(&static.ClientSecret, c.ClientSecret)
+	setString(&static.Username, c.Username)
+	setString(&static.APIKey, c.APIKey)
+
+	return static, nil
+}
+
+// credentialFileNames are the names of the files read from a directory of
+// mounted secrets, such as a Kubernetes secret volume.
+var credentialFileNames = []string{"client_id", "client_secret", "username", "api_key"}
+
+// FileCredentialsProvider reads credentials from a file or a directory and
+// reloads them when they change on disk.
+//
+// A file is parsed like a LoadOptions config file (JSON if it ends in `.json`,
+// YAML otherwise) and only its top-level client_id, client_secret, username and
+// api_key are used. A directory is expected to hold one file per secret named
+// client_id, client_secret, username and api_key; missing files are left empty.
+type FileCredentialsProvider struct {
+	// path is the file or directory to read.
+	path string
+
+	// interval is the minimum time between two checks for changes.
+	interval time.Duration
+
+	// now returns the current time; replaced in tests.
+	now func() time.Time
+
+	// mu guards the fields below.
+	mu sync.Mutex
+
+	// creds holds the last credentials read successfully.
+	creds Credentials
+
+	// stamp identifies the version of the files creds was read from.
+	stamp string
+
+	// checked is when the files were last checked for changes.
+	checked time.Time
+}
+
+// NewFileCredentialsProvider returns a provider reading from path, checking
+// for changes at most once every interval. A zero interval checks on every
+// request. The credentials are read once up front so that a bad path is
+// reported immediately.
+func NewFileCredentialsProvider(path string, interval time.Duration) (*FileCredentialsProvider, error) {
+	p := &FileCredentialsProvider{path: path, interval: interval, now: time.Now}
+
+	stamp, err := p.fingerprint()
+	if err != nil {
+		return nil, err
+	}
+	creds, err := p.read()
+	if err != nil {
+		return nil, err
+	}
+	p.creds, p.stamp, p.checked = creds, stamp, p.now()
+
+	return p, nil
+}
+
+// Credentials returns the current credentials, reloading them if the files
+// changed. If a reload fails, e.g. because a file is being rewritten, the last
+// credentials read successfully are returned and the reload is retried on the
+// next call.
+func (p *FileCredentialsProvider) Credentials() (Credentials, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := p.now()
+	if now.Sub(p.checked) < p.interval {
+		return p.creds, nil
+	}
+	p.checked = now
+
+	stamp, err := p.fingerprint()
+	if err != nil || stamp == p.stamp {
+		return p.creds, nil
+	}
+	creds, err := p.read()
+	if err != nil {
+		return p.creds, nil
+	}
+	p.creds, p.stamp = creds, stamp
+
+	return p.creds, nil
+}
+
+// fingerprint returns a value that changes whenever the files are modified.
+func (p *FileCredentialsProvider) fingerprint() (string, error) {
+	info, err := os.Stat(p.path)
+	if err != nil {
+		return "", errors.Wrap(err, "fail to read credentials")
+	}
+	if !info.IsDir() {
+		return fmt.Sprintf("%d:%d", info.ModTime().UnixNano(), info.Size()), nil
+	}
+
+	parts := []string{}
+	for _, name := range credentialFileNames {
+		info, err := os.Stat(filepath.Join(p.path, name))
+		if err != nil {
+			parts = append(parts, "-")
+			continue
+		}
+		parts = append(parts, fmt.Sprintf("%d:%d", info.ModTime().UnixNano(), info.Size()))
+	}
+
+	return strings.Join(parts, ","), nil
+}
+
+// read loads the credentials from the file or directory.
+func (p *FileCredentialsProvider) read() (Credentials, error) {
+	info, err := os.Stat(p.path)
+	if err != nil {
+		return Credentials{}, errors.Wrap(err, "fail to read credentials")
+	}
+
+	if info.IsDir() {
+		values := map[string]string{}
+		for _, name := range credentialFileNames {
+			data, err := os.ReadFile(filepath.Join(p.path, name))
+			if os.IsNotExist(err) {
+				continue
+			}
+			if err != nil {
+				return Credentials{}, errors.Wrap(err, "fail to read credentials")
+			}
+			values[name] = strings.TrimSpace(string(data))
+		}
+
+		return Credentials{
+			ClientID:     values["client_id"],
+			ClientSecret: values["client_secret"],
+			Username:     values["username"],
+			APIKey:       values["api_key"],
+		}, nil
+	}
+
+	data, err := os.ReadFile(p.path)
+	if err != nil {
+		return Credentials{}, errors.Wrap(err, "fail to read credentials")
+	}
+	f := fileProfile{}
+	if strings.EqualFold(filepath.Ext(p.path), ".json") {
+		err = json.Unmarshal(data, &f)
+	} else {
+		err = yaml.Unmarshal(data, &f)
+	}
+	if err != nil {
+		return Credentials{}, errors.Wrapf(err, "fail to parse credentials file %s", p.path)
+	}
+
+	return Credentials{
+		ClientID:     f.ClientID,
+		ClientSecret: f.ClientSecret,
+		Username:     f.Username,
+		APIKey:       f.APIKey,
+	}, nil
+}
+
+// CachingCredentialsProvider caches the credentials of another provider, such
+// as a CredentialsProviderFunc calling a vault, for a fixed time.
+type CachingCredentialsProvider struct {
+	// source supplies fresh credentials.
+	source CredentialsProvider
+
+	// ttl is how long credentials are cached.
+	ttl time.Duration
+
+	// now returns the current time; replaced in tests.
+	now func() time.Time
+
+	// mu guards the fields below.
+	mu sync.Mutex
+
+	// creds holds the cached credentials.
+	creds Credentials
+
+	// cached reports whether creds holds credentials.
+	cached bool
+
+	// expires is when creds must be refreshed.
+	expires time.Time
+}
+
+// NewCachingCredentialsProvider returns a provider caching the credentials of
+// source for ttl.
+func NewCachingCredentialsProvider(source CredentialsProvider, ttl time.Duration) *CachingCredentialsProvider {
+	return &CachingCredentialsProvider{source: source, ttl: ttl, now: time.Now}
+}
+
+// Credentials returns the cached credentials, refreshing them from the source
+// once they expire. If a refresh fails and credentials were cached before,
+// the stale credentials are returned and the refresh is retried on the next
+// call, so that a brief outage of the source does not fail requests.
+func (p *CachingCredentialsProvider) Credentials() (Credentials, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := p.now()
+	if p.cached && now.Before(p.expires) {
+		return p.creds, nil
+	}
+
+	creds, err := p.source.Credentials()
+	if err != nil {
+		if p.cached {
+			return p.creds, nil
+		}
+		return Credentials{}, err
+	}
+	p.creds, p.cached, p.expires = creds, true, now.Add(p.ttl)
+
+	return p.creds, nil
+}
+
+// Invalidate drops the cached credentials so that the next request fetches
+// fresh ones, e.g. after the API rejected a rotated key.
+func (p *CachingCredentialsProvider) Invalidate() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.cached = false
+}
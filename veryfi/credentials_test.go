@@ -0,0 +1,150 @@
+package veryfi
+
+import (
+	"crypto/tls"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/veryfi/veryfi-go/v4/veryfi/test"
+)
+
+func TestUnitFileCredentialsProvider_File(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "credentials.yaml")
+	assert.NoError(t, os.WriteFile(path, []byte("client_id: id\napi_key: vrfk_old\n"), 0o600))
+
+	p, err := NewFileCredentialsProvider(path, time.Minute)
+	assert.NoError(t, err)
+	now := time.Now()
+	p.now = func() time.Time { return now }
+
+	creds, err := p.Credentials()
+	assert.NoError(t, err)
+	assert.Equal(t, Credentials{ClientID: "id", APIKey: "vrfk_old"}, creds)
+
+	assert.NoError(t, os.WriteFile(path, []byte("client_id: id\napi_key: vrfk_rotated\n"), 0o600))
+	assert.NoError(t, os.Chtimes(path, now, now.Add(time.Second)))
+
+	// Changes are only picked up once the interval has passed.
+	creds, _ = p.Credentials()
+	assert.Equal(t, "vrfk_old", creds.APIKey)
+
+	now = now.Add(time.Minute)
+	creds, _ = p.Credentials()
+	assert.Equal(t, "vrfk_rotated", creds.APIKey)
+
+	// A file that can not be parsed keeps the last good credentials.
+	assert.NoError(t, os.WriteFile(path, []byte("client_id: [\n"), 0o600))
+	assert.NoError(t, os.Chtimes(path, now, now.Add(2*time.Second)))
+	now = now.Add(time.Minute)
+	creds, err = p.Credentials()
+	assert.NoError(t, err)
+	assert.Equal(t, "vrfk_rotated", creds.APIKey)
+}
+
+func TestUnitFileCredentialsProvider_Directory(t *testing.T) {
+	dir := t.TempDir()
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "client_id"), []byte("id\n"), 0o600))
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "api_key"), []byte("vrfk_mounted\n"), 0o600))
+
+	p, err := NewFileCredentialsProvider(dir, 0)
+	assert.NoError(t, err)
+
+	creds, err := p.Credentials()
+	assert.NoError(t, err)
+	assert.Equal(t, Credentials{ClientID: "id", APIKey: "vrfk_mounted"}, creds)
+
+	_, err = NewFileCredentialsProvider(filepath.Join(dir, "missing"), 0)
+	assert.Error(t, err)
+}
+
+func TestUnitCachingCredentialsProvider(t *testing.T) {
+	calls := 0
+	fail := false
+	source := CredentialsProviderFunc(func() (Credentials, error) {
+		calls++
+		if fail {
+			return Credentials{}, errors.New("vault unavailable")
+		}
+		return Credentials{APIKey: "vrfk_" + string(rune('a'+calls))}, nil
+	})
+
+	p := NewCachingCredentialsProvider(source, time.Minute)
+	now := time.Now()
+	p.now = func() time.Time { return now }
+
+	creds, err := p.Credentials()
+	assert.NoError(t, err)
+	assert.Equal(t, "vrfk_b", creds.APIKey)
+
+	creds, _ = p.Credentials()
+	assert.Equal(t, "vrfk_b", creds.APIKey)
+	assert.Equal(t, 1, calls)
+
+	// Stale credentials are served while the source is failing.
+	now = now.Add(time.Minute)
+	fail = true
+	creds, err = p.Credentials()
+	assert.NoError(t, err)
+	assert.Equal(t, "vrfk_b", creds.APIKey)
+
+	fail = false
+	p.Invalidate()
+	creds, _ = p.Credentials()
+	assert.Equal(t, "vrfk_d", creds.APIKey)
+
+	_, err = NewCachingCredentialsProvider(CredentialsProviderFunc(func() (Credentials, error) {
+		return Credentials{}, errors.New("vault unavailable")
+	}), time.Minute).Credentials()
+	assert.EqualError(t, err, "vault unavailable")
+}
+
+func TestUnitClientV8_CredentialsProviderPerRequest(t *testing.T) {
+	server := test.NewHTTPServer()
+	defer server.Close()
+
+	headers := []http.Header{}
+	server.Handle("/api/v8/partner/tags/", func(w http.ResponseWriter, r *http.Request) {
+		headers = append(headers, r.Header.Clone())
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"tags": []}`))
+	})
+
+	key := "vrfk_first"
+	client, err := NewClientV8(&Options{
+		EnvironmentURL: server.URL,
+		ClientID:       "staticClientID",
+		Credentials: CredentialsProviderFunc(func() (Credentials, error) {
+			return Credentials{APIKey: key}, nil
+		}),
+	})
+	assert.NoError(t, err)
+	client.SetTLSConfig(&tls.Config{InsecureSkipVerify: true})
+
+	_, err = client.GetGlobalTags()
+	assert.NoError(t, err)
+	key = "vrfk_second"
+	_, err = client.GetGlobalTags()
+	assert.NoError(t, err)
+
+	assert.Len(t, headers, 2)
+	assert.Equal(t, "staticClientID", headers[0].Get("Client-Id"))
+	assert.Equal(t, "Bearer vrfk_first", headers[0].Get("Authorization"))
+	assert.Equal(t, "Bearer vrfk_second", headers[1].Get("Authorization"))
+}
+
+func TestUnitClientV8_CredentialsProviderError(t *testing.T) {
+	client, err := NewClientV8(&Options{
+		Credentials: CredentialsProviderFunc(func() (Credentials, error) {
+			return Credentials{}, errors.New("vault unavailable")
+		}),
+	})
+	assert.NoError(t, err)
+
+	_, err = client.GetGlobalTags()
+	assert.EqualError(t, err, "fail to get credentials: vault unavailable")
+}
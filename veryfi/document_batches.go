@@ -0,0 +1,118 @@
+package veryfi
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/veryfi/veryfi-go/v4/veryfi/scheme"
+)
+
+// ProcessSplitDocumentUpload submits a file holding several documents, such as
+// a PDF of many invoices, to be split and processed as one document each. It
+// returns the batch handle right away; use WaitDocumentBatch and
+// GetBatchDocuments to retrieve the documents.
+func (c *Client) ProcessSplitDocumentUpload(opts scheme.DocumentUploadOptions) (*scheme.DocumentBatch, error) {
+	out := new(*scheme.DocumentBatch)
+	encodedFile, err := Base64EncodeFile(opts.FilePath)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := scheme.DocumentUploadBase64Options{
+		FileData:              encodedFile,
+		DocumentSharedOptions: opts.DocumentSharedOptions,
+	}
+	if err := c.post(documentBatchURI, payload, out); err != nil {
+		return nil, err
+	}
+
+	return *out, nil
+}
+
+// ProcessSplitDocumentURL submits a file holding several documents using URL
+// to be split and processed as one document each.
+func (c *Client) ProcessSplitDocumentURL(opts scheme.DocumentURLOptions) (*scheme.DocumentBatch, error) {
+	out := new(*scheme.DocumentBatch)
+	if err := c.post(documentBatchURI, opts, out); err != nil {
+		return nil, err
+	}
+
+	return *out, nil
+}
+
+// GetDocumentBatch returns the current state of a document batch.
+func (c *Client) GetDocumentBatch(batchID string) (*scheme.DocumentBatch, error) {
+	out := new(*scheme.DocumentBatch)
+	if err := c.get(fmt.Sprintf("%s%s", documentBatchURI, batchID), nil, out); err != nil {
+		return nil, err
+	}
+
+	return *out, nil
+}
+
+// WaitDocumentBatch polls a document batch every interval until it is no
+// longer in progress, or ctx is done. A failed batch is returned along with
+// an error, and so is a non-positive interval.
+func (c *Client) WaitDocumentBatch(ctx context.Context, batchID string, interval time.Duration) (*scheme.DocumentBatch, error) {
+	if interval <= 0 {
+		return nil, errors.Errorf("poll interval must be positive, got %s", interval)
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		batch, err := c.GetDocumentBatch(batchID)
+		if err != nil {
+			return nil, err
+		}
+		switch batch.Status {
+		case scheme.BatchInProgress:
+		case scheme.BatchFailed:
+			return batch, errors.Errorf("document batch %s failed", batchID)
+		default:
+			return batch, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, errors.Wrap(ctx.Err(), "fail to wait for document batch")
+		case <-ticker.C:
+		}
+	}
+}
+
+// GetBatchDocuments returns the documents a finished batch was split into,
+// sorted by their first page, each with its PageRange set.
+func (c *Client) GetBatchDocuments(batchID string) ([]scheme.Document, error) {
+	batch, err := c.GetDocumentBatch(batchID)
+	if err != nil {
+		return nil, err
+	}
+	if batch.Status == scheme.BatchInProgress {
+		return nil, errors.Errorf("document batch %s is still in progress", batchID)
+	}
+
+	children := slices.Clone(batch.Documents)
+	sort.SliceStable(children, func(i, j int) bool {
+		return children[i].PageRange.First < children[j].PageRange.First
+	})
+
+	docs := make([]scheme.Document, 0, len(children))
+	for _, child := range children {
+		doc, err := c.GetDocument(strconv.Itoa(child.ID), scheme.DocumentGetOptions{})
+		if err != nil {
+			return nil, errors.Wrapf(err, "fail to get document %d of batch %s", child.ID, batchID)
+		}
+		pages := child.PageRange
+		doc.PageRange = &pages
+		docs = append(docs, *doc)
+	}
+
+	return docs, nil
+}
@@ -0,0 +1,104 @@
+package veryfi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/veryfi/veryfi-go/v4/veryfi/scheme"
+	"github.com/veryfi/veryfi-go/v4/veryfi/test"
+)
+
+func setUpDocumentBatch(t *testing.T, finalStatus string) (test.HTTPServer, *Client, map[string]any) {
+	server := test.NewHTTPServer()
+
+	body := map[string]any{}
+	server.Handle("/api/v8/partner/documents-set/", func(w http.ResponseWriter, r *http.Request) {
+		captureBody(t, r, body)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id": 77, "status": "in_progress"}`))
+	})
+
+	polls := int32(0)
+	server.Handle("/api/v8/partner/documents-set/77", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if atomic.AddInt32(&polls, 1) < 3 {
+			w.Write([]byte(`{"id": 77, "status": "in_progress"}`))
+			return
+		}
+		fmt.Fprintf(w, `{"id": 77, "status": %q, "documents": [
+			{"id": 12, "page_range": {"first": 3, "last": 3}},
+			{"id": 11, "page_range": {"first": 1, "last": 2}}
+		]}`, finalStatus)
+	})
+	for _, id := range []int{11, 12} {
+		server.Handle(fmt.Sprintf("/api/v8/partner/documents/%d", id), func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprintf(w, `{"id": %d, "invoice_number": "INV-%d", "total": 10.5}`, id, id)
+		})
+	}
+
+	return server, newTestClient(t, server), body
+}
+
+func TestUnitClientV8_SplitDocument(t *testing.T) {
+	server, client, body := setUpDocumentBatch(t, "processed")
+	defer server.Close()
+
+	batch, err := client.ProcessSplitDocumentUpload(scheme.DocumentUploadOptions{
+		FilePath: "testdata/receipt_public.jpg",
+		DocumentSharedOptions: scheme.DocumentSharedOptions{
+			Tags: []string{"vendor-pack"},
+		},
+	})
+	assert.NoError(t, err)
+	assertResponse(t, &scheme.DocumentBatch{ID: 77, Status: scheme.BatchInProgress}, batch)
+	assert.NotEmpty(t, body["file_data"])
+	assert.Equal(t, []any{"vendor-pack"}, body["tags"])
+
+	// Documents can not be retrieved before the batch is processed.
+	_, err = client.GetBatchDocuments("77")
+	assert.EqualError(t, err, "document batch 77 is still in progress")
+
+	batch, err = client.WaitDocumentBatch(context.Background(), "77", time.Millisecond)
+	assert.NoError(t, err)
+	assert.Equal(t, scheme.BatchProcessed, batch.Status)
+	assert.Len(t, batch.Documents, 2)
+
+	docs, err := client.GetBatchDocuments("77")
+	assert.NoError(t, err)
+	assert.Len(t, docs, 2)
+	assert.Equal(t, "INV-11", docs[0].InvoiceNumber)
+	assert.Equal(t, &scheme.PageRange{First: 1, Last: 2}, docs[0].PageRange)
+	assert.Equal(t, "1-2", docs[0].PageRange.String())
+	assert.Equal(t, 12, docs[1].ID)
+	assert.Equal(t, "3", docs[1].PageRange.String())
+
+	_, err = client.ProcessSplitDocumentURL(scheme.DocumentURLOptions{FileURL: "https://example.com/pack.pdf"})
+	assert.NoError(t, err)
+	assert.Equal(t, "https://example.com/pack.pdf", body["file_url"])
+}
+
+func TestUnitClientV8_WaitDocumentBatch(t *testing.T) {
+	server, client, _ := setUpDocumentBatch(t, "failed")
+	defer server.Close()
+
+	batch, err := client.WaitDocumentBatch(context.Background(), "77", time.Millisecond)
+	assert.EqualError(t, err, "document batch 77 failed")
+	assert.Equal(t, scheme.BatchFailed, batch.Status)
+
+	server, client, _ = setUpDocumentBatch(t, "processed")
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = client.WaitDocumentBatch(ctx, "77", time.Hour)
+	assert.EqualError(t, err, "fail to wait for document batch: context canceled")
+
+	_, err = client.WaitDocumentBatch(context.Background(), "77", -time.Second)
+	assert.EqualError(t, err, "poll interval must be positive, got -1s")
+}
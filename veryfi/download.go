@@ -0,0 +1,276 @@
+package veryfi
+
+import (
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/creasty/defaults"
+	"github.com/pkg/errors"
+	"github.com/veryfi/veryfi-go/v4/veryfi/scheme"
+)
+
+// DownloadKind describes which file of a document to download.
+type DownloadKind string
+
+const (
+	// DownloadOriginal is the file as it was submitted, from ImgURL.
+	DownloadOriginal DownloadKind = "original"
+
+	// DownloadThumbnail is the thumbnail image, from ImgThumbnailURL.
+	DownloadThumbnail DownloadKind = "thumbnail"
+
+	// DownloadPDF is the PDF rendition, from PDFURL.
+	DownloadPDF DownloadKind = "pdf"
+)
+
+// url returns the signed URL of the file of doc.
+func (k DownloadKind) url(doc *scheme.Document) (string, error) {
+	switch k {
+	case DownloadOriginal:
+		return doc.ImgURL, nil
+	case DownloadThumbnail:
+		return doc.ImgThumbnailURL, nil
+	case DownloadPDF:
+		return doc.PDFURL, nil
+	default:
+		return "", errors.Errorf("unknown download kind %q", k)
+	}
+}
+
+// accepts reports whether a response content type is valid for the kind. An
+// unknown or generic binary type is accepted.
+func (k DownloadKind) accepts(contentType string) bool {
+	mediaType := strings.ToLower(strings.TrimSpace(strings.Split(contentType, ";")[0]))
+	if mediaType == "" || mediaType == "application/octet-stream" {
+		return true
+	}
+
+	isImage := strings.HasPrefix(mediaType, "image/")
+	isPDF := mediaType == "application/pdf"
+	switch k {
+	case DownloadOriginal:
+		return isImage || isPDF
+	case DownloadThumbnail:
+		return isImage
+	default:
+		return isPDF
+	}
+}
+
+// Download describes a downloaded file.
+type Download struct {
+	Kind        DownloadKind
+	ContentType string
+	Size        int64
+}
+
+// DownloadDocument streams a file of doc to w, using the client's transport,
+// timeout and retry options. The signed file URLs of a document expire; when
+// the file server rejects an expired URL, doc is refreshed with GetDocument
+// and the download is retried once, so doc holds fresh URLs afterwards.
+//
+// The download fails if its content type does not match kind, e.g. an HTML
+// error page, or if fewer bytes than announced arrive. In the latter case w
+// may already hold part of the file.
+func (c *Client) DownloadDocument(doc *scheme.Document, kind DownloadKind, w io.Writer) (*Download, error) {
+	fileURL, err := kind.url(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	if fileURL != "" {
+		out, err := c.download(fileURL, kind, w)
+		if !errors.Is(err, errExpiredURL) {
+			return out, err
+		}
+	}
+
+	fresh, err := c.GetDocument(strconv.Itoa(doc.ID), scheme.DocumentGetOptions{})
+	if err != nil {
+		return nil, errors.Wrap(err, "fail to refresh document URLs")
+	}
+	*doc = *fresh
+	if fileURL, _ = kind.url(doc); fileURL == "" {
+		return nil, errors.Errorf("document %d has no %s file", doc.ID, kind)
+	}
+
+	return c.download(fileURL, kind, w)
+}
+
+// errExpiredURL is returned by download when the file server rejects the URL.
+var errExpiredURL = errors.New("signed URL expired")
+
+// download streams the file at fileURL to w.
+func (c *Client) download(fileURL string, kind DownloadKind, w io.Writer) (*Download, error) {
+	start := time.Now()
+	resp, err := c.client.R().SetDoNotParseResponse(true).Get(fileURL)
+	c.record(resp, start)
+	if err != nil {
+		return nil, errors.Wrap(err, "fail to download file")
+	}
+	body := resp.RawBody()
+	defer body.Close()
+
+	switch {
+	case resp.StatusCode() == http.StatusForbidden:
+		return nil, errExpiredURL
+	case resp.IsError():
+		return nil, errors.Errorf("fail to download file: status=%s", resp.Status())
+	}
+
+	contentType := resp.Header().Get("Content-Type")
+	if !kind.accepts(contentType) {
+		return nil, errors.Errorf("fail to download file: unexpected content type %q for %s", contentType, kind)
+	}
+
+	n, err := io.Copy(w, body)
+	if err != nil {
+		return nil, errors.Wrap(err, "fail to download file")
+	}
+	if expected := resp.RawResponse.ContentLength; expected >= 0 && n != expected {
+		return nil, errors.Errorf("fail to download file: got %d bytes, expected %d", n, expected)
+	}
+
+	return &Download{Kind: kind, ContentType: contentType, Size: n}, nil
+}
+
+// BulkDownloadOptions describes the options of DownloadDocuments.
+type BulkDownloadOptions struct {
+	// Kinds lists the files to download for each document. It defaults to
+	// the original file only.
+	Kinds []DownloadKind
+
+	// Concurrency is the number of files downloaded at once.
+	Concurrency int `default:"4"`
+}
+
+// DownloadedFile describes the outcome of downloading one file in bulk.
+type DownloadedFile struct {
+	DocumentID int
+	Kind       DownloadKind
+
+	// Path is where the file was written, named after the document ID and
+	// the kind, e.g. "36966934_original.jpg".
+	Path string
+
+	// Err is set if the download failed, in which case no file is left
+	// behind.
+	Err error
+}
+
+// DownloadDocuments downloads the files of docs into dir, which is created if
+// needed. Failed downloads do not stop the others; the result lists every
+// file in order and an error is returned if any of them failed. It records no
+// response metadata, even on a client made by WithResponse.
+func (c *Client) DownloadDocuments(docs []scheme.Document, dir string, opts BulkDownloadOptions) ([]DownloadedFile, error) {
+	if err := defaults.Set(&opts); err != nil {
+		return nil, errors.Wrap(err, "fail to set default download options")
+	}
+	if len(opts.Kinds) == 0 {
+		opts.Kinds = []DownloadKind{DownloadOriginal}
+	}
+	if opts.Concurrency < 1 {
+		opts.Concurrency = 1
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, errors.Wrap(err, "fail to create download directory")
+	}
+
+	results := make([]DownloadedFile, 0, len(docs)*len(opts.Kinds))
+	for _, doc := range docs {
+		for _, kind := range opts.Kinds {
+			results = append(results, DownloadedFile{DocumentID: doc.ID, Kind: kind})
+		}
+	}
+
+	// Files are downloaded concurrently, so no response metadata is recorded.
+	c = c.withResponse(nil)
+
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, opts.Concurrency)
+	for i := range results {
+		result := &results[i]
+		// Each download gets its own copy, as refreshing updates it.
+		doc := docs[i/len(opts.Kinds)]
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+			result.Path, result.Err = c.downloadToDir(&doc, result.Kind, dir)
+		}()
+	}
+	wg.Wait()
+
+	failed := 0
+	for _, r := range results {
+		if r.Err != nil {
+			failed++
+		}
+	}
+	if failed > 0 {
+		return results, errors.Errorf("fail to download %d of %d files", failed, len(results))
+	}
+
+	return results, nil
+}
+
+// downloadToDir downloads a file of doc into dir and returns its path.
+func (c *Client) downloadToDir(doc *scheme.Document, kind DownloadKind, dir string) (string, error) {
+	tmp, err := os.CreateTemp(dir, ".download-*")
+	if err != nil {
+		return "", errors.Wrap(err, "fail to create file")
+	}
+	defer os.Remove(tmp.Name())
+
+	dl, err := c.DownloadDocument(doc, kind, tmp)
+	if closeErr := tmp.Close(); err == nil && closeErr != nil {
+		err = errors.Wrap(closeErr, "fail to write file")
+	}
+	if err != nil {
+		return "", err
+	}
+
+	fileURL, _ := kind.url(doc)
+	name := filepath.Join(dir, strconv.Itoa(doc.ID)+"_"+string(kind)+fileExtension(fileURL, dl.ContentType))
+	if err := os.Rename(tmp.Name(), name); err != nil {
+		return "", errors.Wrap(err, "fail to write file")
+	}
+
+	return name, nil
+}
+
+// fileExtension returns the extension of a downloaded file, from its URL or
+// else its content type.
+func fileExtension(fileURL string, contentType string) string {
+	if u, err := url.Parse(fileURL); err == nil {
+		if ext := path.Ext(u.Path); ext != "" {
+			return strings.ToLower(ext)
+		}
+	}
+
+	switch strings.Split(contentType, ";")[0] {
+	case "image/jpeg":
+		return ".jpg"
+	case "image/png":
+		return ".png"
+	case "image/gif":
+		return ".gif"
+	case "image/webp":
+		return ".webp"
+	case "image/tiff":
+		return ".tiff"
+	case "application/pdf":
+		return ".pdf"
+	default:
+		return ""
+	}
+}
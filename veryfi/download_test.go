@@ -0,0 +1,157 @@
+package veryfi
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/veryfi/veryfi-go/v4/veryfi/scheme"
+	"github.com/veryfi/veryfi-go/v4/veryfi/test"
+)
+
+var (
+	mockImage = []byte("\xff\xd8\xffmock-jpeg")
+	mockPDF   = []byte("%PDF-1.7 mock")
+)
+
+func setUpDownloads(t *testing.T) (test.HTTPServer, *Client, *int32) {
+	server := test.NewHTTPServer()
+	base := "https://" + server.URL
+
+	serveFile := func(contentType string, data []byte) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			assert.Empty(t, r.Header.Get("Authorization"))
+			w.Header().Set("Content-Type", contentType)
+			w.Write(data)
+		}
+	}
+	server.Handle("/files/1.jpg", serveFile("image/jpeg", mockImage))
+	server.Handle("/files/1_t.jpg", serveFile("text/html", []byte("<html>oops</html>")))
+	server.Handle("/files/1.pdf", serveFile("application/pdf", mockPDF))
+	server.Handle("/files/fresh.jpg", serveFile("image/jpeg", mockImage))
+	server.Handle("/files/expired.jpg", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	})
+	server.Handle("/files/truncated.jpg", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "image/jpeg")
+		w.Header().Set("Content-Length", "100")
+		w.Write(mockImage)
+	})
+
+	// Documents 2 to 9 have expired URLs, refreshed with fresh ones.
+	refreshes := int32(0)
+	for id := 2; id <= 9; id++ {
+		server.Handle(fmt.Sprintf("/api/v8/partner/documents/%d", id), func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&refreshes, 1)
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprintf(w, `{"id": %d, "img_url": %q}`, id, base+"/files/fresh.jpg?Signature=new")
+		})
+	}
+
+	return server, newTestClient(t, server), &refreshes
+}
+
+func TestUnitClientV8_DownloadDocument(t *testing.T) {
+	server, client, refreshes := setUpDownloads(t)
+	defer server.Close()
+	base := "https://" + server.URL
+
+	doc := &scheme.Document{
+		ID:              1,
+		ImgURL:          base + "/files/1.jpg?Signature=abc",
+		ImgThumbnailURL: base + "/files/1_t.jpg",
+		PDFURL:          base + "/files/1.pdf",
+	}
+
+	buf := &bytes.Buffer{}
+	dl, err := client.DownloadDocument(doc, DownloadOriginal, buf)
+	assert.NoError(t, err)
+	assert.Equal(t, &Download{Kind: DownloadOriginal, ContentType: "image/jpeg", Size: int64(len(mockImage))}, dl)
+	assert.Equal(t, mockImage, buf.Bytes())
+
+	buf.Reset()
+	dl, err = client.DownloadDocument(doc, DownloadPDF, buf)
+	assert.NoError(t, err)
+	assert.Equal(t, "application/pdf", dl.ContentType)
+	assert.Equal(t, mockPDF, buf.Bytes())
+
+	_, err = client.DownloadDocument(doc, DownloadThumbnail, &bytes.Buffer{})
+	assert.EqualError(t, err, `fail to download file: unexpected content type "text/html" for thumbnail`)
+
+	doc.ImgURL = base + "/files/truncated.jpg"
+	_, err = client.DownloadDocument(doc, DownloadOriginal, &bytes.Buffer{})
+	assert.Error(t, err)
+	assert.Equal(t, int32(0), *refreshes)
+}
+
+func TestUnitClientV8_DownloadDocumentExpired(t *testing.T) {
+	server, client, refreshes := setUpDownloads(t)
+	defer server.Close()
+
+	doc := &scheme.Document{ID: 2, ImgURL: "https://" + server.URL + "/files/expired.jpg?Signature=old"}
+	buf := &bytes.Buffer{}
+	_, err := client.DownloadDocument(doc, DownloadOriginal, buf)
+	assert.NoError(t, err)
+	assert.Equal(t, mockImage, buf.Bytes())
+	assert.Equal(t, int32(1), *refreshes)
+	assert.Contains(t, doc.ImgURL, "Signature=new")
+
+	// A missing URL is refreshed too, and reported if still missing.
+	_, err = client.DownloadDocument(&scheme.Document{ID: 2}, DownloadPDF, buf)
+	assert.EqualError(t, err, "document 2 has no pdf file")
+	assert.Equal(t, int32(2), *refreshes)
+}
+
+func TestUnitClientV8_DownloadDocuments(t *testing.T) {
+	server, client, _ := setUpDownloads(t)
+	defer server.Close()
+	base := "https://" + server.URL
+
+	docs := []scheme.Document{
+		{ID: 1, ImgURL: base + "/files/1.jpg?Signature=abc", PDFURL: base + "/files/1.pdf"},
+		{ID: 2, ImgURL: base + "/files/expired.jpg"},
+	}
+	dir := filepath.Join(t.TempDir(), "downloads")
+
+	results, err := client.DownloadDocuments(docs, dir, BulkDownloadOptions{
+		Kinds: []DownloadKind{DownloadOriginal, DownloadPDF},
+	})
+	assert.EqualError(t, err, "fail to download 1 of 4 files")
+	assert.Len(t, results, 4)
+
+	assert.Equal(t, filepath.Join(dir, "1_original.jpg"), results[0].Path)
+	assert.Equal(t, filepath.Join(dir, "1_pdf.pdf"), results[1].Path)
+	assert.Equal(t, filepath.Join(dir, "2_original.jpg"), results[2].Path)
+	assert.NoError(t, results[2].Err)
+	assert.Equal(t, 2, results[3].DocumentID)
+	assert.EqualError(t, results[3].Err, "document 2 has no pdf file")
+
+	data, err := os.ReadFile(results[1].Path)
+	assert.NoError(t, err)
+	assert.Equal(t, mockPDF, data)
+
+	entries, err := os.ReadDir(dir)
+	assert.NoError(t, err)
+	assert.Len(t, entries, 3)
+}
+
+func TestUnitClientV8_DownloadDocumentsRefreshed(t *testing.T) {
+	server, client, refreshes := setUpDownloads(t)
+	defer server.Close()
+
+	// Several documents refreshed at once share the client.
+	var docs []scheme.Document
+	for id := 2; id <= 9; id++ {
+		docs = append(docs, scheme.Document{ID: id, ImgURL: "https://" + server.URL + "/files/expired.jpg"})
+	}
+
+	results, err := client.DownloadDocuments(docs, t.TempDir(), BulkDownloadOptions{Concurrency: 8})
+	assert.NoError(t, err)
+	assert.Len(t, results, 8)
+	assert.Equal(t, int32(8), *refreshes)
+}
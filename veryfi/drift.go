@@ -0,0 +1,64 @@
+package veryfi
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/pkg/errors"
+	"github.com/veryfi/veryfi-go/v4/veryfi/scheme"
+)
+
+// DriftReport describes how a response differs from the type the client
+// decodes it into.
+type DriftReport struct {
+	// Method and URL identify the request.
+	Method string
+	URL    string
+
+	// Type is the type the response is decoded into, e.g. "scheme.Document".
+	Type string
+
+	Drifts []scheme.Drift
+}
+
+// decode decodes the body of a successful response into okScheme when
+// OnDrift is set, and reports the drift it finds to OnDrift. Otherwise resty
+// has decoded it already.
+func (c *Client) decode(resp *resty.Response, okScheme interface{}) error {
+	body := resp.Body()
+	if c.options.OnDrift == nil || !resp.IsSuccess() || len(bytes.TrimSpace(body)) == 0 {
+		return nil
+	}
+
+	drifts, err := scheme.DetectDrift(body, okScheme)
+	if err != nil {
+		return errors.Wrap(err, "fail to decode the response")
+	}
+	mismatch := false
+	for _, d := range drifts {
+		mismatch = mismatch || d.Kind == scheme.TypeMismatch
+	}
+	if len(drifts) > 0 {
+		t := reflect.TypeOf(okScheme)
+		for t.Kind() == reflect.Pointer {
+			t = t.Elem()
+		}
+		c.options.OnDrift(DriftReport{
+			Method: resp.Request.Method,
+			URL:    resp.Request.URL,
+			Type:   t.String(),
+			Drifts: drifts,
+		})
+	}
+
+	// The values that do not fit are reported above, and left zero. Other
+	// errors still fail the call.
+	var typeErr *json.UnmarshalTypeError
+	if err := json.Unmarshal(body, okScheme); err != nil && !(mismatch && errors.As(err, &typeErr)) {
+		return errors.Wrap(err, "fail to decode the response")
+	}
+
+	return nil
+}
@@ -0,0 +1,73 @@
+package veryfi
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/veryfi/veryfi-go/v4/veryfi/scheme"
+	"github.com/veryfi/veryfi-go/v4/veryfi/test"
+)
+
+func TestUnitClientV8_OnDrift(t *testing.T) {
+	server := test.NewHTTPServer()
+	defer server.Close()
+
+	server.Handle("/api/v8/partner/documents/42/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id": 42, "guest_count": 3, "total": 12.5, "vendor": {"name": "Walgreens", "loyalty_tier": "gold"}}`))
+	})
+	client := newTestClient(t, server)
+
+	// Without OnDrift, a value of the wrong type fails the call.
+	_, err := client.GetDocument("42", scheme.DocumentGetOptions{})
+	assert.Error(t, err)
+
+	var reports []DriftReport
+	client.Config().OnDrift = func(r DriftReport) {
+		reports = append(reports, r)
+	}
+	doc, err := client.GetDocument("42", scheme.DocumentGetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, 42, doc.ID)
+	assert.Equal(t, "", doc.GuestCount)
+	assert.Equal(t, scheme.MustParseAmount("12.5"), doc.Total)
+	assert.Equal(t, "Walgreens", doc.Vendor.Name)
+	assert.Contains(t, doc.Vendor.Extra, "loyalty_tier")
+
+	assert.Len(t, reports, 1)
+	assert.Equal(t, http.MethodGet, reports[0].Method)
+	assert.Contains(t, reports[0].URL, "/documents/42")
+	assert.Equal(t, "scheme.Document", reports[0].Type)
+	assert.Equal(t, []scheme.Drift{
+		{Kind: scheme.TypeMismatch, Path: "guest_count", JSONType: "number", GoType: "string", Value: []byte("3")},
+		{Kind: scheme.UnknownField, Path: "vendor.loyalty_tier", JSONType: "string", Value: []byte(`"gold"`)},
+	}, reports[0].Drifts)
+
+	// The fields after a value of the wrong type are decoded too.
+	server.Handle("/api/v8/partner/documents/44/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"total": "abc", "id": 44, "vendor": {"name": "X"}, "subtotal": 4}`))
+	})
+	doc, err = client.GetDocument("44", scheme.DocumentGetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, 44, doc.ID)
+	assert.True(t, doc.Total.IsZero())
+	assert.Equal(t, "X", doc.Vendor.Name)
+	assert.Equal(t, scheme.AmountFromInt(4), doc.Subtotal)
+	assert.Len(t, reports, 2)
+	assert.Equal(t, []scheme.Drift{
+		{Kind: scheme.TypeMismatch, Path: "total", JSONType: "string", GoType: "scheme.Amount", Value: []byte(`"abc"`)},
+	}, reports[1].Drifts)
+	reports = nil
+
+	// Responses that match their type are not reported.
+	server.Handle("/api/v8/partner/documents/43/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id": 43}`))
+	})
+	doc, err = client.GetDocument("43", scheme.DocumentGetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, 43, doc.ID)
+	assert.Empty(t, reports)
+}
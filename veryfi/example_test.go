@@ -5,12 +5,13 @@ import (
 	"log"
 	"time"
 
-	"github.com/veryfi/veryfi-go/v3/veryfi"
-	"github.com/veryfi/veryfi-go/v3/veryfi/scheme"
+	"github.com/veryfi/veryfi-go/v4/veryfi"
+	"github.com/veryfi/veryfi-go/v4/veryfi/scheme"
 )
 
-func float64Ptr(v float64) *float64 {
-	return &v
+func amountPtr(v string) *scheme.Amount {
+	a := scheme.MustParseAmount(v)
+	return &a
 }
 
 func stringPtr(v string) *string {
@@ -22,6 +23,7 @@ func ExampleClient_processDocument() {
 	if err != nil {
 		log.Fatal(err)
 	}
+	retries := uint(1)
 
 	// Initialize a Veryfi Client for v8 API.
 	client, err := veryfi.NewClientV8(&veryfi.Options{
@@ -31,7 +33,7 @@ func ExampleClient_processDocument() {
 		HTTP: veryfi.HTTPOptions{
 			Timeout: timeout,
 			Retry: veryfi.RetryOptions{
-				Count: 1,
+				Count: &retries,
 			},
 		},
 	})
@@ -73,6 +75,7 @@ func ExampleClient_manageDocument() {
 	if err != nil {
 		log.Fatal(err)
 	}
+	retries := uint(1)
 
 	// Initialize a Veryfi Client for v8 API.
 	client, err := veryfi.NewClientV8(&veryfi.Options{
@@ -82,7 +85,7 @@ func ExampleClient_manageDocument() {
 		HTTP: veryfi.HTTPOptions{
 			Timeout: timeout,
 			Retry: veryfi.RetryOptions{
-				Count: 1,
+				Count: &retries,
 			},
 		},
 	})
@@ -136,6 +139,7 @@ func ExampleClient_manageLineItem() {
 	if err != nil {
 		log.Fatal(err)
 	}
+	retries := uint(1)
 
 	// Initialize a Veryfi Client for v8 API.
 	client, err := veryfi.NewClientV8(&veryfi.Options{
@@ -145,7 +149,7 @@ func ExampleClient_manageLineItem() {
 		HTTP: veryfi.HTTPOptions{
 			Timeout: timeout,
 			Retry: veryfi.RetryOptions{
-				Count: 1,
+				Count: &retries,
 			},
 		},
 	})
@@ -162,7 +166,7 @@ func ExampleClient_manageLineItem() {
 	resp, err := client.AddLineItem(documentID, scheme.LineItemOptions{
 		Order:       1,
 		Description: stringPtr("Example"),
-		Total:       float64Ptr(1.0),
+		Total:       amountPtr("1.00"),
 	})
 	if err != nil {
 		log.Fatal(err)
@@ -189,7 +193,7 @@ func ExampleClient_manageLineItem() {
 	resp, err = client.UpdateLineItem(documentID, lineItemID, scheme.LineItemOptions{
 		Order:       6,
 		Description: stringPtr("Example"),
-		Total:       float64Ptr(6.6),
+		Total:       amountPtr("6.60"),
 	})
 	if err != nil {
 		log.Fatal(err)
@@ -209,6 +213,7 @@ func ExampleClient_manageTag() {
 	if err != nil {
 		log.Fatal(err)
 	}
+	retries := uint(1)
 
 	// Initialize a Veryfi Client for v8 API.
 	client, err := veryfi.NewClientV8(&veryfi.Options{
@@ -218,7 +223,7 @@ func ExampleClient_manageTag() {
 		HTTP: veryfi.HTTPOptions{
 			Timeout: timeout,
 			Retry: veryfi.RetryOptions{
-				Count: 1,
+				Count: &retries,
 			},
 		},
 	})
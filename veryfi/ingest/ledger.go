@@ -0,0 +1,104 @@
+package ingest
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// entry records a file that has been uploaded.
+type entry struct {
+	// File is the name of the file when it was uploaded.
+	File string `json:"file"`
+
+	// DocumentID is the ID of the processed document.
+	DocumentID int `json:"document_id"`
+
+	// Uploaded is when the upload succeeded.
+	Uploaded time.Time `json:"uploaded"`
+}
+
+// ledger is the persistent record of uploaded files, keyed by the SHA-256 of
+// their content. It lets a restarted watcher recognise files that were
+// uploaded but not yet moved, and files dropped more than once.
+type ledger struct {
+	path    string
+	entries map[string]entry
+}
+
+// openLedger loads the ledger at path, or starts an empty one if the file does
+// not exist yet.
+func openLedger(path string) (*ledger, error) {
+	l := &ledger{path: path, entries: map[string]entry{}}
+
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return l, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "fail to read state file")
+	}
+	if err := json.Unmarshal(data, &l.entries); err != nil {
+		return nil, errors.Wrapf(err, "fail to parse state file %s", path)
+	}
+
+	return l, nil
+}
+
+// lookup returns the entry of a content hash.
+func (l *ledger) lookup(hash string) (entry, bool) {
+	e, ok := l.entries[hash]
+	return e, ok
+}
+
+// record adds an entry and persists the ledger atomically.
+func (l *ledger) record(hash string, e entry) error {
+	l.entries[hash] = e
+
+	data, err := json.MarshalIndent(l.entries, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(l.path), filepath.Base(l.path)+".*")
+	if err != nil {
+		return errors.Wrap(err, "fail to write state file")
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return errors.Wrap(err, "fail to write state file")
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return errors.Wrap(err, "fail to write state file")
+	}
+	if err := tmp.Close(); err != nil {
+		return errors.Wrap(err, "fail to write state file")
+	}
+
+	return errors.Wrap(os.Rename(tmp.Name(), l.path), "fail to write state file")
+}
+
+// hashFile returns the hex-encoded SHA-256 of a file's content.
+func hashFile(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
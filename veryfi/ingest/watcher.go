@@ -0,0 +1,333 @@
+// Package ingest implements a watch-folder ingestion loop that uploads files
+// dropped into a directory to Veryfi.
+package ingest
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/creasty/defaults"
+	"github.com/pkg/errors"
+
+	"github.com/veryfi/veryfi-go/v4/veryfi/scheme"
+)
+
+// Uploader processes an uploaded document. It is satisfied by veryfi.API.
+type Uploader interface {
+	ProcessDocumentUpload(opts scheme.DocumentUploadOptions) (*scheme.Document, error)
+}
+
+// Options is the config options of a Watcher.
+type Options struct {
+	// Dir is the directory to watch. Sub-directories are not scanned.
+	Dir string `default:"-"`
+
+	// DoneDir receives uploaded files. Defaults to Dir/done.
+	DoneDir string `default:"-"`
+
+	// FailedDir receives files that failed to upload, each next to a
+	// `<name>.error` file holding the reason. Defaults to Dir/failed.
+	FailedDir string `default:"-"`
+
+	// StateFile records uploaded files so that a restarted watcher does not
+	// upload them again. Defaults to Dir/.veryfi-ingest.json.
+	StateFile string `default:"-"`
+
+	// Interval is the time between two polls of Dir. Defaults to 10s; it can
+	// not be negative.
+	Interval time.Duration `default:"10s"`
+
+	// SettleTime is how long a file must go unmodified before it is picked
+	// up, so that scans still being written are left alone.
+	SettleTime time.Duration `default:"5s"`
+
+	// Patterns restricts the files picked up to those whose name matches one
+	// of the filepath.Match patterns, e.g. "*.pdf". Empty means every file.
+	Patterns []string
+
+	// ExternalIDFromFileName sets the external ID of each document to its
+	// file name without extension.
+	ExternalIDFromFileName bool
+
+	// Shared holds the processing options applied to every upload. FileName
+	// is always set to the name of the uploaded file.
+	Shared scheme.DocumentSharedOptions
+}
+
+// Status describes the outcome of a file.
+type Status string
+
+const (
+	// Uploaded means the file was uploaded and moved to DoneDir.
+	Uploaded Status = "uploaded"
+
+	// Duplicate means the file's content was already uploaded, so it was moved
+	// to DoneDir without uploading it again.
+	Duplicate Status = "duplicate"
+
+	// Failed means the file could not be uploaded and was moved to FailedDir.
+	Failed Status = "failed"
+)
+
+// Result is the outcome of a single file.
+type Result struct {
+	// Path is where the file was found.
+	Path string
+
+	// Status is the outcome.
+	Status Status
+
+	// DocumentID is the ID of the document the file was processed as. It is
+	// set for Uploaded and Duplicate.
+	DocumentID int
+
+	// Document is the processed document. It is only set for Uploaded.
+	Document *scheme.Document
+
+	// Err is the reason of a failure.
+	Err error
+}
+
+// Watcher polls a directory and uploads the files dropped into it.
+type Watcher struct {
+	// api uploads the files.
+	api Uploader
+
+	// options is the config options of the watcher.
+	options Options
+
+	// ledger records uploaded files.
+	ledger *ledger
+
+	// now returns the current time; replaced in tests.
+	now func() time.Time
+}
+
+// NewWatcher returns a watcher over opts.Dir. It creates the done and failed
+// directories and loads the state file.
+func NewWatcher(api Uploader, opts Options) (*Watcher, error) {
+	if api == nil {
+		return nil, errors.New("uploader can not be nil")
+	}
+	if err := defaults.Set(&opts); err != nil {
+		return nil, errors.New("failed to set default configs")
+	}
+	if opts.Dir == "" {
+		return nil, errors.New("watch directory can not be empty")
+	}
+	if opts.Interval < 0 {
+		return nil, errors.Errorf("poll interval can not be negative, got %s", opts.Interval)
+	}
+	if opts.DoneDir == "" {
+		opts.DoneDir = filepath.Join(opts.Dir, "done")
+	}
+	if opts.FailedDir == "" {
+		opts.FailedDir = filepath.Join(opts.Dir, "failed")
+	}
+	if opts.StateFile == "" {
+		opts.StateFile = filepath.Join(opts.Dir, ".veryfi-ingest.json")
+	}
+	for _, p := range opts.Patterns {
+		if _, err := filepath.Match(p, ""); err != nil {
+			return nil, errors.Wrapf(err, "invalid pattern %q", p)
+		}
+	}
+
+	for _, dir := range []string{opts.DoneDir, opts.FailedDir} {
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			return nil, errors.Wrap(err, "fail to create directory")
+		}
+	}
+
+	l, err := openLedger(opts.StateFile)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Watcher{api: api, options: opts, ledger: l, now: time.Now}, nil
+}
+
+// Config returns the watcher configuration options.
+func (w *Watcher) Config() Options {
+	return w.options
+}
+
+// Run polls the directory every Interval until ctx is done, calling onResult
+// for each file handled. It returns ctx.Err() on cancellation, or the first
+// error that prevents polling, such as an unreadable directory.
+func (w *Watcher) Run(ctx context.Context, onResult func(Result)) error {
+	ticker := time.NewTicker(w.options.Interval)
+	defer ticker.Stop()
+
+	for {
+		results, err := w.Poll(ctx)
+		if onResult != nil {
+			for _, r := range results {
+				onResult(r)
+			}
+		}
+		if err != nil {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Poll handles every settled file currently in the directory once, in name
+// order, and returns their results.
+func (w *Watcher) Poll(ctx context.Context) ([]Result, error) {
+	files, err := w.pending()
+	if err != nil {
+		return nil, err
+	}
+
+	results := []Result{}
+	for _, path := range files {
+		if err := ctx.Err(); err != nil {
+			return results, err
+		}
+
+		r, err := w.handle(path)
+		results = append(results, r)
+		if err != nil {
+			return results, err
+		}
+	}
+
+	return results, nil
+}
+
+// pending lists the settled files in the directory that match the patterns.
+func (w *Watcher) pending() ([]string, error) {
+	entries, err := os.ReadDir(w.options.Dir)
+	if err != nil {
+		return nil, errors.Wrap(err, "fail to read watch directory")
+	}
+
+	files := []string{}
+	for _, e := range entries {
+		name := e.Name()
+		if !e.Type().IsRegular() || strings.HasPrefix(name, ".") || !w.matches(name) {
+			continue
+		}
+
+		path := filepath.Join(w.options.Dir, name)
+		if path == w.options.StateFile {
+			continue
+		}
+
+		info, err := e.Info()
+		if err != nil {
+			continue
+		}
+		if w.now().Sub(info.ModTime()) < w.options.SettleTime {
+			continue
+		}
+
+		files = append(files, path)
+	}
+	sort.Strings(files)
+
+	return files, nil
+}
+
+// matches reports whether name matches one of the patterns.
+func (w *Watcher) matches(name string) bool {
+	if len(w.options.Patterns) == 0 {
+		return true
+	}
+	for _, p := range w.options.Patterns {
+		if ok, _ := filepath.Match(p, name); ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// handle uploads a single file and moves it out of the directory. The
+// returned error is only set when the watcher can not safely continue, e.g.
+// the state file can not be written.
+func (w *Watcher) handle(path string) (Result, error) {
+	r := Result{Path: path}
+	name := filepath.Base(path)
+
+	hash, err := hashFile(path)
+	if err != nil {
+		return w.fail(r, errors.Wrap(err, "fail to read file"))
+	}
+
+	if e, ok := w.ledger.lookup(hash); ok {
+		r.Status = Duplicate
+		r.DocumentID = e.DocumentID
+		_, err := w.move(path, w.options.DoneDir)
+		return r, err
+	}
+
+	shared := w.options.Shared
+	shared.FileName = name
+	if w.options.ExternalIDFromFileName {
+		shared.ExternalID = strings.TrimSuffix(name, filepath.Ext(name))
+	}
+
+	doc, err := w.api.ProcessDocumentUpload(scheme.DocumentUploadOptions{
+		FilePath:              path,
+		DocumentSharedOptions: shared,
+	})
+	if err != nil {
+		return w.fail(r, err)
+	}
+
+	// Record the upload before moving the file: if the move fails or the
+	// process dies in between, the next poll finds the file in the ledger and
+	// only moves it.
+	if err := w.ledger.record(hash, entry{File: name, DocumentID: doc.ID, Uploaded: w.now().UTC()}); err != nil {
+		return r, err
+	}
+
+	r.Status = Uploaded
+	r.DocumentID = doc.ID
+	r.Document = doc
+	_, err = w.move(path, w.options.DoneDir)
+	return r, err
+}
+
+// fail moves a file to FailedDir along with a file holding the reason.
+func (w *Watcher) fail(r Result, reason error) (Result, error) {
+	r.Status = Failed
+	r.Err = reason
+
+	dst, err := w.move(r.Path, w.options.FailedDir)
+	if err != nil {
+		return r, err
+	}
+
+	return r, errors.Wrap(os.WriteFile(dst+".error", []byte(reason.Error()+"\n"), 0o644), "fail to write error file")
+}
+
+// move moves a file into dir and returns its new path. A timestamp is added to
+// the name if a file with the same name is already there.
+func (w *Watcher) move(path string, dir string) (string, error) {
+	name := filepath.Base(path)
+	dst := filepath.Join(dir, name)
+	if _, err := os.Stat(dst); err == nil {
+		ext := filepath.Ext(name)
+		dst = filepath.Join(dir, fmt.Sprintf("%s.%s%s", strings.TrimSuffix(name, ext), w.now().UTC().Format("20060102T150405.000000000"), ext))
+	}
+
+	if err := os.Rename(path, dst); err != nil {
+		return "", errors.Wrap(err, "fail to move file")
+	}
+
+	return dst, nil
+}
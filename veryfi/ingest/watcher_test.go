@@ -0,0 +1,126 @@
+package ingest
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/veryfi/veryfi-go/v4/veryfi/mock"
+	"github.com/veryfi/veryfi-go/v4/veryfi/scheme"
+)
+
+// newTestWatcher returns a watcher over a fresh directory whose files are
+// settled immediately.
+func newTestWatcher(t *testing.T, m *mock.Client, opts Options) *Watcher {
+	if opts.Dir == "" {
+		opts.Dir = t.TempDir()
+	}
+	w, err := NewWatcher(m, opts)
+	assert.NoError(t, err)
+	w.now = func() time.Time { return time.Now().Add(time.Hour) }
+
+	return w
+}
+
+// drop writes a file into dir.
+func drop(t *testing.T, dir string, name string, content string) {
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644))
+}
+
+func TestUnitWatcher_UploadsAndMoves(t *testing.T) {
+	m := &mock.Client{
+		ProcessDocumentUploadFunc: func(opts scheme.DocumentUploadOptions) (*scheme.Document, error) {
+			if opts.FileName == "bad.pdf" {
+				return nil, errors.New("unsupported file")
+			}
+			return &scheme.Document{ID: 100 + len(opts.FileName)}, nil
+		},
+	}
+	w := newTestWatcher(t, m, Options{
+		Patterns:               []string{"*.pdf"},
+		ExternalIDFromFileName: true,
+		Shared:                 scheme.DocumentSharedOptions{Tags: []string{"mailroom"}},
+	})
+	dir := w.Config().Dir
+	drop(t, dir, "inv-001.pdf", "a")
+	drop(t, dir, "bad.pdf", "b")
+	drop(t, dir, "notes.txt", "c")
+
+	results, err := w.Poll(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, results, 2)
+	assert.Equal(t, Failed, results[0].Status)
+	assert.EqualError(t, results[0].Err, "unsupported file")
+	assert.Equal(t, Uploaded, results[1].Status)
+	assert.Equal(t, 111, results[1].DocumentID)
+
+	opts := m.CallsTo("ProcessDocumentUpload")[1].Args[0].(scheme.DocumentUploadOptions)
+	assert.Equal(t, "inv-001", opts.ExternalID)
+	assert.Equal(t, []string{"mailroom"}, opts.Tags)
+
+	assert.FileExists(t, filepath.Join(dir, "done", "inv-001.pdf"))
+	assert.FileExists(t, filepath.Join(dir, "failed", "bad.pdf"))
+	assert.FileExists(t, filepath.Join(dir, "failed", "bad.pdf.error"))
+	assert.FileExists(t, filepath.Join(dir, "notes.txt"))
+}
+
+func TestUnitWatcher_NoDuplicateUploadsAcrossRestarts(t *testing.T) {
+	m := &mock.Client{
+		ProcessDocumentUploadFunc: func(opts scheme.DocumentUploadOptions) (*scheme.Document, error) {
+			return &scheme.Document{ID: 1}, nil
+		},
+	}
+	w := newTestWatcher(t, m, Options{})
+	dir := w.Config().Dir
+	drop(t, dir, "receipt.jpg", "same content")
+
+	_, err := w.Poll(context.Background())
+	assert.NoError(t, err)
+
+	// Simulate a crash after the upload but before the move by putting the
+	// file back, then drop the same content under a new name and restart.
+	assert.NoError(t, os.Rename(filepath.Join(dir, "done", "receipt.jpg"), filepath.Join(dir, "receipt.jpg")))
+	drop(t, dir, "receipt-copy.jpg", "same content")
+
+	w = newTestWatcher(t, m, Options{Dir: dir})
+	results, err := w.Poll(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, results, 2)
+	for _, r := range results {
+		assert.Equal(t, Duplicate, r.Status)
+		assert.Equal(t, 1, r.DocumentID)
+	}
+	assert.Len(t, m.CallsTo("ProcessDocumentUpload"), 1)
+	assert.FileExists(t, filepath.Join(dir, "done", "receipt-copy.jpg"))
+}
+
+func TestUnitWatcher_SkipsUnsettledFiles(t *testing.T) {
+	m := &mock.Client{}
+	dir := t.TempDir()
+	w, err := NewWatcher(m, Options{Dir: dir, SettleTime: time.Hour})
+	assert.NoError(t, err)
+	drop(t, dir, "scan.pdf", "partial")
+
+	results, err := w.Poll(context.Background())
+	assert.NoError(t, err)
+	assert.Empty(t, results)
+	assert.Empty(t, m.Calls())
+}
+
+func TestUnitNewWatcher_Invalid(t *testing.T) {
+	_, err := NewWatcher(&mock.Client{}, Options{})
+	assert.Error(t, err)
+
+	_, err = NewWatcher(&mock.Client{}, Options{Dir: t.TempDir(), Patterns: []string{"["}})
+	assert.Error(t, err)
+
+	_, err = NewWatcher(nil, Options{Dir: t.TempDir()})
+	assert.Error(t, err)
+
+	_, err = NewWatcher(&mock.Client{}, Options{Dir: t.TempDir(), Interval: -time.Second})
+	assert.EqualError(t, err, "poll interval can not be negative, got -1s")
+}
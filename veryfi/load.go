@@ -0,0 +1,261 @@
+package veryfi
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v3"
+)
+
+// Environment variables read by LoadOptions.
+const (
+	EnvClientID         = "VERYFI_CLIENT_ID"
+	EnvClientSecret     = "VERYFI_CLIENT_SECRET"
+	EnvUsername         = "VERYFI_USERNAME"
+	EnvAPIKey           = "VERYFI_API_KEY"
+	EnvEnvironmentURL   = "VERYFI_ENVIRONMENT_URL"
+	EnvHTTPTimeout      = "VERYFI_HTTP_TIMEOUT"
+	EnvRetryCount       = "VERYFI_RETRY_COUNT"
+	EnvRetryWaitTime    = "VERYFI_RETRY_WAIT_TIME"
+	EnvRetryMaxWaitTime = "VERYFI_RETRY_MAX_WAIT_TIME"
+	EnvProfile          = "VERYFI_PROFILE"
+	EnvConfigFile       = "VERYFI_CONFIG"
+)
+
+// DefaultProfile is the profile used when none is selected.
+const DefaultProfile = "default"
+
+// LoadConfig describes where LoadOptions reads the options from.
+type LoadConfig struct {
+	// File is the path of a YAML or JSON config file. Files ending in
+	// `.json` are parsed as JSON, anything else as YAML. When empty,
+	// VERYFI_CONFIG is used, then the first of ~/.veryfi/config.yaml,
+	// ~/.veryfi/config.yml and ~/.veryfi/config.json that exists.
+	File string
+
+	// Profile selects a named profile of the config file. When empty,
+	// VERYFI_PROFILE is used, then DefaultProfile.
+	Profile string
+
+	// Getenv looks up environment variables. Defaults to os.Getenv.
+	Getenv func(key string) string
+}
+
+// fileProfile is the set of options a config file, or one of its profiles,
+// may specify. Durations use time.ParseDuration syntax, e.g. "30s".
+type fileProfile struct {
+	EnvironmentURL string   `json:"environment_url" yaml:"environment_url"`
+	ClientID       string   `json:"client_id" yaml:"client_id"`
+	ClientSecret   string   `json:"client_secret" yaml:"client_secret"`
+	Username       string   `json:"username" yaml:"username"`
+	APIKey         string   `json:"api_key" yaml:"api_key"`
+	HTTP           fileHTTP `json:"http" yaml:"http"`
+}
+
+// fileHTTP is the HTTP section of a config file.
+type fileHTTP struct {
+	Timeout string    `json:"timeout" yaml:"timeout"`
+	Retry   fileRetry `json:"retry" yaml:"retry"`
+}
+
+// fileRetry is the retry section of a config file.
+type fileRetry struct {
+	Count       *uint  `json:"count" yaml:"count"`
+	WaitTime    string `json:"wait_time" yaml:"wait_time"`
+	MaxWaitTime string `json:"max_wait_time" yaml:"max_wait_time"`
+}
+
+// configFile describes a config file, e.g.
+//
+//	client_id: YOUR_CLIENT_ID
+//	http:
+//	  timeout: 30s
+//	profiles:
+//	  sandbox:
+//	    environment_url: sandbox.api.veryfi.com
+//	    api_key: vrfk_SANDBOX_KEY
+//	  production:
+//	    api_key: vrfk_PRODUCTION_KEY
+//
+// Top-level options apply to every profile; the selected profile overrides them.
+type configFile struct {
+	fileProfile `yaml:",inline"`
+
+	Profiles map[string]fileProfile `json:"profiles" yaml:"profiles"`
+}
+
+// LoadOptions builds Options from, in increasing order of precedence, the
+// built-in defaults, the top-level options of the config file, the selected
+// profile of the config file and environment variables. A missing default
+// config file is not an error, but a file or profile that was selected
+// explicitly must exist. The result is not validated; call Options.Validate.
+func LoadOptions(cfg LoadConfig) (*Options, error) {
+	getenv := cfg.Getenv
+	if getenv == nil {
+		getenv = os.Getenv
+	}
+
+	opts := &Options{}
+	if err := setDefaults(opts); err != nil {
+		return nil, err
+	}
+
+	file, err := readConfigFile(cfg, getenv)
+	if err != nil {
+		return nil, err
+	}
+
+	if file != nil {
+		if err := file.fileProfile.apply(opts); err != nil {
+			return nil, err
+		}
+
+		name := firstNonEmpty(cfg.Profile, getenv(EnvProfile))
+		explicit := name != ""
+		if !explicit {
+			name = DefaultProfile
+		}
+
+		p, ok := file.Profiles[name]
+		if !ok && explicit {
+			return nil, errors.Errorf("profile %q not found in config file", name)
+		}
+		if err := p.apply(opts); err != nil {
+			return nil, errors.Wrapf(err, "invalid profile %q", name)
+		}
+	} else if name := firstNonEmpty(cfg.Profile, getenv(EnvProfile)); name != "" {
+		return nil, errors.Errorf("profile %q selected but no config file found", name)
+	}
+
+	if err := applyEnv(opts, getenv); err != nil {
+		return nil, err
+	}
+
+	return opts, nil
+}
+
+// readConfigFile reads the config file selected by cfg, or returns nil if no
+// file was selected and none exists at the default locations.
+func readConfigFile(cfg LoadConfig, getenv func(string) string) (*configFile, error) {
+	path := firstNonEmpty(cfg.File, getenv(EnvConfigFile))
+	if path == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return nil, nil
+		}
+		for _, name := range []string{"config.yaml", "config.yml", "config.json"} {
+			candidate := filepath.Join(home, ".veryfi", name)
+			if _, err := os.Stat(candidate); err == nil {
+				path = candidate
+				break
+			}
+		}
+		if path == "" {
+			return nil, nil
+		}
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "fail to read config file")
+	}
+
+	file := &configFile{}
+	if strings.EqualFold(filepath.Ext(path), ".json") {
+		err = json.Unmarshal(data, file)
+	} else {
+		err = yaml.Unmarshal(data, file)
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "fail to parse config file %s", path)
+	}
+
+	return file, nil
+}
+
+// apply overrides opts with the options set in the profile.
+func (p fileProfile) apply(opts *Options) error {
+	setString(&opts.EnvironmentURL, p.EnvironmentURL)
+	setString(&opts.ClientID, p.ClientID)
+	setString(&opts.ClientSecret, p.ClientSecret)
+	setString(&opts.Username, p.Username)
+	setString(&opts.APIKey, p.APIKey)
+
+	if p.HTTP.Retry.Count != nil {
+		count := *p.HTTP.Retry.Count
+		opts.HTTP.Retry.Count = &count
+	}
+
+	if err := setDuration(&opts.HTTP.Timeout, "http.timeout", p.HTTP.Timeout); err != nil {
+		return err
+	}
+	if err := setDuration(&opts.HTTP.Retry.WaitTime, "http.retry.wait_time", p.HTTP.Retry.WaitTime); err != nil {
+		return err
+	}
+	return setDuration(&opts.HTTP.Retry.MaxWaitTime, "http.retry.max_wait_time", p.HTTP.Retry.MaxWaitTime)
+}
+
+// applyEnv overrides opts with the options set in environment variables.
+func applyEnv(opts *Options, getenv func(string) string) error {
+	setString(&opts.EnvironmentURL, getenv(EnvEnvironmentURL))
+	setString(&opts.ClientID, getenv(EnvClientID))
+	setString(&opts.ClientSecret, getenv(EnvClientSecret))
+	setString(&opts.Username, getenv(EnvUsername))
+	setString(&opts.APIKey, getenv(EnvAPIKey))
+
+	if v := getenv(EnvRetryCount); v != "" {
+		count, err := strconv.ParseUint(v, 10, 32)
+		if err != nil {
+			return errors.Wrapf(err, "invalid %s", EnvRetryCount)
+		}
+		retries := uint(count)
+		opts.HTTP.Retry.Count = &retries
+	}
+
+	if err := setDuration(&opts.HTTP.Timeout, EnvHTTPTimeout, getenv(EnvHTTPTimeout)); err != nil {
+		return err
+	}
+	if err := setDuration(&opts.HTTP.Retry.WaitTime, EnvRetryWaitTime, getenv(EnvRetryWaitTime)); err != nil {
+		return err
+	}
+	return setDuration(&opts.HTTP.Retry.MaxWaitTime, EnvRetryMaxWaitTime, getenv(EnvRetryMaxWaitTime))
+}
+
+// setDuration parses v and sets dst unless v is empty. name identifies the
+// setting in errors.
+func setDuration(dst *time.Duration, name string, v string) error {
+	if v == "" {
+		return nil
+	}
+
+	d, err := time.ParseDuration(v)
+	if err != nil {
+		return errors.Wrapf(err, "invalid %s", name)
+	}
+	*dst = d
+
+	return nil
+}
+
+// setString sets dst to v unless v is empty.
+func setString(dst *string, v string) {
+	if v != "" {
+		*dst = v
+	}
+}
+
+// firstNonEmpty returns the first non-empty value.
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+
+	return ""
+}
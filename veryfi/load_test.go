@@ -0,0 +1,125 @@
+package veryfi
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// writeConfig writes a config file into a temporary directory.
+func writeConfig(t *testing.T, name string, content string) string {
+	path := filepath.Join(t.TempDir(), name)
+	assert.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+// environ returns a Getenv backed by a map.
+func environ(env map[string]string) func(string) string {
+	return func(k string) string { return env[k] }
+}
+
+const yamlConfig = `
+client_id: fileClientID
+username: fileUsername
+http:
+  timeout: 30s
+  retry:
+    count: 1
+profiles:
+  sandbox:
+    environment_url: sandbox.api.veryfi.com
+    api_key: vrfk_sandbox
+  production:
+    api_key: vrfk_production
+`
+
+func TestUnitLoadOptions_Precedence(t *testing.T) {
+	path := writeConfig(t, "veryfi.yaml", yamlConfig)
+
+	opts, err := LoadOptions(LoadConfig{
+		File:    path,
+		Profile: "sandbox",
+		Getenv:  environ(map[string]string{EnvUsername: "envUsername", EnvRetryWaitTime: "250ms"}),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, &Options{
+		EnvironmentURL: "sandbox.api.veryfi.com",
+		ClientID:       "fileClientID",
+		Username:       "envUsername",
+		APIKey:         "vrfk_sandbox",
+		HTTP: HTTPOptions{
+			Timeout: 30 * time.Second,
+			Retry: RetryOptions{
+				Count:       uintPtr(1),
+				WaitTime:    250 * time.Millisecond,
+				MaxWaitTime: 360 * time.Second,
+			},
+			RequestIDHeader: "X-Request-Id",
+		},
+	}, opts)
+
+	// The profile may also be selected through the environment.
+	opts, err = LoadOptions(LoadConfig{File: path, Getenv: environ(map[string]string{EnvProfile: "production"})})
+	assert.NoError(t, err)
+	assert.Equal(t, "api.veryfi.com", opts.EnvironmentURL)
+	assert.Equal(t, "vrfk_production", opts.APIKey)
+}
+
+func TestUnitLoadOptions_JSON(t *testing.T) {
+	path := writeConfig(t, "veryfi.json", `{"profiles": {"default": {"client_id": "jsonClientID", "api_key": "vrfk_json"}}}`)
+
+	opts, err := LoadOptions(LoadConfig{File: path, Getenv: environ(nil)})
+	assert.NoError(t, err)
+	assert.Equal(t, "jsonClientID", opts.ClientID)
+	assert.Equal(t, "vrfk_json", opts.APIKey)
+	assert.NoError(t, opts.Validate())
+}
+
+func TestUnitLoadOptions_EnvOnly(t *testing.T) {
+	opts, err := LoadOptions(LoadConfig{
+		File: writeConfig(t, "empty.yaml", ""),
+		Getenv: environ(map[string]string{
+			EnvClientID:    "envClientID",
+			EnvAPIKey:      "vrfk_env",
+			EnvHTTPTimeout: "5s",
+			EnvRetryCount:  "0",
+		}),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "envClientID", opts.ClientID)
+	assert.Equal(t, 5*time.Second, opts.HTTP.Timeout)
+	assert.Equal(t, uintPtr(0), opts.HTTP.Retry.Count)
+
+	// A loaded retry count of 0 is not replaced with the default by the client.
+	client, err := NewClientV8(opts)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, client.client.RetryCount)
+	assert.Equal(t, 5*time.Second, client.client.GetClient().Timeout)
+
+	// A zero timeout would turn the time limit off, so it is reported.
+	opts, err = LoadOptions(LoadConfig{
+		File:   writeConfig(t, "empty.yaml", ""),
+		Getenv: environ(map[string]string{EnvClientID: "envClientID", EnvAPIKey: "vrfk_env", EnvHTTPTimeout: "0s"}),
+	})
+	assert.NoError(t, err)
+	assert.EqualError(t, opts.Validate(), "invalid options: HTTP timeout must be positive")
+}
+
+func TestUnitLoadOptions_Errors(t *testing.T) {
+	path := writeConfig(t, "veryfi.yaml", yamlConfig)
+
+	_, err := LoadOptions(LoadConfig{File: path, Profile: "staging", Getenv: environ(nil)})
+	assert.EqualError(t, err, `profile "staging" not found in config file`)
+
+	_, err = LoadOptions(LoadConfig{File: filepath.Join(t.TempDir(), "missing.yaml"), Getenv: environ(nil)})
+	assert.ErrorContains(t, err, "fail to read config file")
+
+	_, err = LoadOptions(LoadConfig{File: writeConfig(t, "bad.yaml", "http:\n  timeout: soon\n"), Getenv: environ(nil)})
+	assert.ErrorContains(t, err, "invalid http.timeout")
+
+	_, err = LoadOptions(LoadConfig{File: path, Getenv: environ(map[string]string{EnvRetryCount: "-1"})})
+	assert.ErrorContains(t, err, "invalid "+EnvRetryCount)
+}
@@ -0,0 +1,781 @@
+// Package mock provides a programmable fake of veryfi.API for unit tests.
+package mock
+
+import (
+	"context"
+	"crypto/tls"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/veryfi/veryfi-go/v4/veryfi"
+	"github.com/veryfi/veryfi-go/v4/veryfi/scheme"
+)
+
+// ErrNotConfigured is returned by a mocked method whose Func field is not set.
+var ErrNotConfigured = errors.New("mock: method is not configured")
+
+// Call records a single invocation of a mocked method.
+type Call struct {
+	// Method is the name of the invoked method, e.g. "GetDocument".
+	Method string
+
+	// Args holds the arguments in the order they were passed.
+	Args []interface{}
+}
+
+// Client is a fake implementation of veryfi.API. Each method delegates to the
+// matching Func field when it is set and otherwise returns ErrNotConfigured.
+// Every invocation is recorded and can be inspected via Calls and CallsTo. It
+// is safe for concurrent use.
+type Client struct {
+	ConfigFunc                        func() *veryfi.Options
+	SetTLSConfigFunc                  func(config *tls.Config)
+	WithResponseFunc                  func(meta *veryfi.ResponseMeta) veryfi.API
+	ProcessDocumentUploadFunc         func(opts scheme.DocumentUploadOptions) (*scheme.Document, error)
+	ProcessDetailedDocumentUploadFunc func(opts scheme.DocumentUploadOptions) (*scheme.DetailedDocument, error)
+	ProcessDocumentURLFunc            func(opts scheme.DocumentURLOptions) (*scheme.Document, error)
+	ProcessDetailedDocumentURLFunc    func(opts scheme.DocumentURLOptions) (*scheme.DetailedDocument, error)
+	UpdateDocumentFunc                func(documentID string, opts scheme.DocumentUpdateOptions) (*scheme.Document, error)
+	SearchDocumentsFunc               func(opts scheme.DocumentSearchOptions) (*scheme.Documents, error)
+	SearchDetailedDocumentsFunc       func(opts scheme.DocumentSearchOptions) (*scheme.DetailedDocuments, error)
+	GetDocumentFunc                   func(documentID string, opts scheme.DocumentGetOptions) (*scheme.Document, error)
+	GetDetailedDocumentFunc           func(documentID string, opts scheme.DocumentGetOptions) (*scheme.DetailedDocument, error)
+	DeleteDocumentFunc                func(documentID string) error
+	GetLineItemsFunc                  func(documentID string) (*scheme.LineItems, error)
+	AddLineItemFunc                   func(documentID string, opts scheme.LineItemOptions) (*scheme.LineItem, error)
+	GetLineItemFunc                   func(documentID string, lineItemID string) (*scheme.LineItem, error)
+	UpdateLineItemFunc                func(documentID string, lineItemID string, opts scheme.LineItemOptions) (*scheme.LineItem, error)
+	DeleteLineItemFunc                func(documentID string, lineItemID string) error
+	GetTagsFunc                       func(documentID string) (*scheme.Tags, error)
+	GetGlobalTagsFunc                 func() (*scheme.Tags, error)
+	AddTagFunc                        func(documentID string, opts scheme.TagOptions) (*scheme.Tag, error)
+	DeleteTagFunc                     func(documentID string, tagID string) error
+	DeleteGlobalTagFunc               func(tagID string) error
+
+	ProcessAnyDocumentUploadFunc         func(opts scheme.AnyDocumentUploadOptions) (*scheme.AnyDocument, error)
+	ProcessDetailedAnyDocumentUploadFunc func(opts scheme.AnyDocumentUploadOptions) (*scheme.AnyDocument, error)
+	ProcessAnyDocumentURLFunc            func(opts scheme.AnyDocumentURLOptions) (*scheme.AnyDocument, error)
+	ProcessDetailedAnyDocumentURLFunc    func(opts scheme.AnyDocumentURLOptions) (*scheme.AnyDocument, error)
+	GetAnyDocumentFunc                   func(documentID string, opts scheme.AnyDocumentGetOptions) (*scheme.AnyDocument, error)
+	SearchAnyDocumentsFunc               func(opts scheme.AnyDocumentSearchOptions) (*scheme.AnyDocuments, error)
+	DeleteAnyDocumentFunc                func(documentID string) error
+
+	ProcessBankStatementUploadFunc func(opts scheme.BankStatementUploadOptions) (*scheme.BankStatement, error)
+	ProcessBankStatementURLFunc    func(opts scheme.BankStatementURLOptions) (*scheme.BankStatement, error)
+	GetBankStatementFunc           func(documentID string, opts scheme.BankStatementGetOptions) (*scheme.BankStatement, error)
+	SearchBankStatementsFunc       func(opts scheme.BankStatementSearchOptions) (*scheme.BankStatements, error)
+	DeleteBankStatementFunc        func(documentID string) error
+
+	ProcessCheckUploadFunc func(opts scheme.CheckUploadOptions) (*scheme.Check, error)
+	ProcessCheckURLFunc    func(opts scheme.CheckURLOptions) (*scheme.Check, error)
+	GetCheckFunc           func(documentID string, opts scheme.CheckGetOptions) (*scheme.Check, error)
+	SearchChecksFunc       func(opts scheme.CheckSearchOptions) (*scheme.Checks, error)
+	DeleteCheckFunc        func(documentID string) error
+
+	ProcessW2UploadFunc func(opts scheme.TaxFormUploadOptions) (*scheme.W2Form, error)
+	ProcessW2URLFunc    func(opts scheme.TaxFormURLOptions) (*scheme.W2Form, error)
+	GetW2Func           func(documentID string) (*scheme.W2Form, error)
+	SearchW2sFunc       func(opts scheme.TaxFormSearchOptions) (*scheme.W2Forms, error)
+	DeleteW2Func        func(documentID string) error
+	ProcessW9UploadFunc func(opts scheme.TaxFormUploadOptions) (*scheme.W9Form, error)
+	ProcessW9URLFunc    func(opts scheme.TaxFormURLOptions) (*scheme.W9Form, error)
+	GetW9Func           func(documentID string) (*scheme.W9Form, error)
+	SearchW9sFunc       func(opts scheme.TaxFormSearchOptions) (*scheme.W9Forms, error)
+	DeleteW9Func        func(documentID string) error
+	ProcessW8UploadFunc func(opts scheme.TaxFormUploadOptions) (*scheme.W8Form, error)
+	ProcessW8URLFunc    func(opts scheme.TaxFormURLOptions) (*scheme.W8Form, error)
+	GetW8Func           func(documentID string) (*scheme.W8Form, error)
+	SearchW8sFunc       func(opts scheme.TaxFormSearchOptions) (*scheme.W8Forms, error)
+	DeleteW8Func        func(documentID string) error
+
+	ProcessBusinessCardUploadFunc func(opts scheme.BusinessCardUploadOptions) (*scheme.BusinessCard, error)
+	ProcessBusinessCardURLFunc    func(opts scheme.BusinessCardURLOptions) (*scheme.BusinessCard, error)
+	GetBusinessCardFunc           func(documentID string) (*scheme.BusinessCard, error)
+	SearchBusinessCardsFunc       func(opts scheme.BusinessCardSearchOptions) (*scheme.BusinessCards, error)
+	DeleteBusinessCardFunc        func(documentID string) error
+
+	ClassifyDocumentUploadFunc func(opts scheme.ClassifyUploadOptions) (*scheme.Classification, error)
+	ClassifyDocumentURLFunc    func(opts scheme.ClassifyURLOptions) (*scheme.Classification, error)
+
+	ProcessSplitDocumentUploadFunc func(opts scheme.DocumentUploadOptions) (*scheme.DocumentBatch, error)
+	ProcessSplitDocumentURLFunc    func(opts scheme.DocumentURLOptions) (*scheme.DocumentBatch, error)
+	GetDocumentBatchFunc           func(batchID string) (*scheme.DocumentBatch, error)
+	WaitDocumentBatchFunc          func(ctx context.Context, batchID string, interval time.Duration) (*scheme.DocumentBatch, error)
+	GetBatchDocumentsFunc          func(batchID string) ([]scheme.Document, error)
+
+	DownloadDocumentFunc  func(doc *scheme.Document, kind veryfi.DownloadKind, w io.Writer) (*veryfi.Download, error)
+	DownloadDocumentsFunc func(docs []scheme.Document, dir string, opts veryfi.BulkDownloadOptions) ([]veryfi.DownloadedFile, error)
+
+	// mu guards calls.
+	mu sync.Mutex
+
+	// calls holds every recorded invocation in order.
+	calls []Call
+}
+
+// Ensure Client satisfies the veryfi.API interface.
+var _ veryfi.API = (*Client)(nil)
+
+// Calls returns a copy of every recorded invocation in order.
+func (m *Client) Calls() []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	out := make([]Call, len(m.calls))
+	copy(out, m.calls)
+	return out
+}
+
+// CallsTo returns the recorded invocations of the given method in order.
+func (m *Client) CallsTo(method string) []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	out := []Call{}
+	for _, c := range m.calls {
+		if c.Method == method {
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
+// Reset clears the recorded invocations. Configured Func fields are kept.
+func (m *Client) Reset() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.calls = nil
+}
+
+// record appends an invocation to the call log.
+func (m *Client) record(method string, args ...interface{}) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.calls = append(m.calls, Call{Method: method, Args: args})
+}
+
+// Config calls ConfigFunc, or returns nil if it is not set.
+func (m *Client) Config() *veryfi.Options {
+	m.record("Config")
+	if m.ConfigFunc == nil {
+		return nil
+	}
+	return m.ConfigFunc()
+}
+
+// SetTLSConfig calls SetTLSConfigFunc if it is set.
+func (m *Client) SetTLSConfig(config *tls.Config) {
+	m.record("SetTLSConfig", config)
+	if m.SetTLSConfigFunc != nil {
+		m.SetTLSConfigFunc(config)
+	}
+}
+
+// WithResponse calls WithResponseFunc, or returns m itself if it is not set,
+// so that the calls made through the result are recorded by m.
+func (m *Client) WithResponse(meta *veryfi.ResponseMeta) veryfi.API {
+	m.record("WithResponse", meta)
+	if m.WithResponseFunc == nil {
+		return m
+	}
+	return m.WithResponseFunc(meta)
+}
+
+// ProcessDocumentUpload calls ProcessDocumentUploadFunc.
+func (m *Client) ProcessDocumentUpload(opts scheme.DocumentUploadOptions) (*scheme.Document, error) {
+	m.record("ProcessDocumentUpload", opts)
+	if m.ProcessDocumentUploadFunc == nil {
+		return nil, ErrNotConfigured
+	}
+	return m.ProcessDocumentUploadFunc(opts)
+}
+
+// ProcessDetailedDocumentUpload calls ProcessDetailedDocumentUploadFunc.
+func (m *Client) ProcessDetailedDocumentUpload(opts scheme.DocumentUploadOptions) (*scheme.DetailedDocument, error) {
+	m.record("ProcessDetailedDocumentUpload", opts)
+	if m.ProcessDetailedDocumentUploadFunc == nil {
+		return nil, ErrNotConfigured
+	}
+	return m.ProcessDetailedDocumentUploadFunc(opts)
+}
+
+// ProcessDocumentURL calls ProcessDocumentURLFunc.
+func (m *Client) ProcessDocumentURL(opts scheme.DocumentURLOptions) (*scheme.Document, error) {
+	m.record("ProcessDocumentURL", opts)
+	if m.ProcessDocumentURLFunc == nil {
+		return nil, ErrNotConfigured
+	}
+	return m.ProcessDocumentURLFunc(opts)
+}
+
+// ProcessDetailedDocumentURL calls ProcessDetailedDocumentURLFunc.
+func (m *Client) ProcessDetailedDocumentURL(opts scheme.DocumentURLOptions) (*scheme.DetailedDocument, error) {
+	m.record("ProcessDetailedDocumentURL", opts)
+	if m.ProcessDetailedDocumentURLFunc == nil {
+		return nil, ErrNotConfigured
+	}
+	return m.ProcessDetailedDocumentURLFunc(opts)
+}
+
+// UpdateDocument calls UpdateDocumentFunc.
+func (m *Client) UpdateDocument(documentID string, opts scheme.DocumentUpdateOptions) (*scheme.Document, error) {
+	m.record("UpdateDocument", documentID, opts)
+	if m.UpdateDocumentFunc == nil {
+		return nil, ErrNotConfigured
+	}
+	return m.UpdateDocumentFunc(documentID, opts)
+}
+
+// SearchDocuments calls SearchDocumentsFunc.
+func (m *Client) SearchDocuments(opts scheme.DocumentSearchOptions) (*scheme.Documents, error) {
+	m.record("SearchDocuments", opts)
+	if m.SearchDocumentsFunc == nil {
+		return nil, ErrNotConfigured
+	}
+	return m.SearchDocumentsFunc(opts)
+}
+
+// SearchDetailedDocuments calls SearchDetailedDocumentsFunc.
+func (m *Client) SearchDetailedDocuments(opts scheme.DocumentSearchOptions) (*scheme.DetailedDocuments, error) {
+	m.record("SearchDetailedDocuments", opts)
+	if m.SearchDetailedDocumentsFunc == nil {
+		return nil, ErrNotConfigured
+	}
+	return m.SearchDetailedDocumentsFunc(opts)
+}
+
+// GetDocument calls GetDocumentFunc.
+func (m *Client) GetDocument(documentID string, opts scheme.DocumentGetOptions) (*scheme.Document, error) {
+	m.record("GetDocument", documentID, opts)
+	if m.GetDocumentFunc == nil {
+		return nil, ErrNotConfigured
+	}
+	return m.GetDocumentFunc(documentID, opts)
+}
+
+// GetDetailedDocument calls GetDetailedDocumentFunc.
+func (m *Client) GetDetailedDocument(documentID string, opts scheme.DocumentGetOptions) (*scheme.DetailedDocument, error) {
+	m.record("GetDetailedDocument", documentID, opts)
+	if m.GetDetailedDocumentFunc == nil {
+		return nil, ErrNotConfigured
+	}
+	return m.GetDetailedDocumentFunc(documentID, opts)
+}
+
+// DeleteDocument calls DeleteDocumentFunc.
+func (m *Client) DeleteDocument(documentID string) error {
+	m.record("DeleteDocument", documentID)
+	if m.DeleteDocumentFunc == nil {
+		return ErrNotConfigured
+	}
+	return m.DeleteDocumentFunc(documentID)
+}
+
+// GetLineItems calls GetLineItemsFunc.
+func (m *Client) GetLineItems(documentID string) (*scheme.LineItems, error) {
+	m.record("GetLineItems", documentID)
+	if m.GetLineItemsFunc == nil {
+		return nil, ErrNotConfigured
+	}
+	return m.GetLineItemsFunc(documentID)
+}
+
+// AddLineItem calls AddLineItemFunc.
+func (m *Client) AddLineItem(documentID string, opts scheme.LineItemOptions) (*scheme.LineItem, error) {
+	m.record("AddLineItem", documentID, opts)
+	if m.AddLineItemFunc == nil {
+		return nil, ErrNotConfigured
+	}
+	return m.AddLineItemFunc(documentID, opts)
+}
+
+// GetLineItem calls GetLineItemFunc.
+func (m *Client) GetLineItem(documentID string, lineItemID string) (*scheme.LineItem, error) {
+	m.record("GetLineItem", documentID, lineItemID)
+	if m.GetLineItemFunc == nil {
+		return nil, ErrNotConfigured
+	}
+	return m.GetLineItemFunc(documentID, lineItemID)
+}
+
+// UpdateLineItem calls UpdateLineItemFunc.
+func (m *Client) UpdateLineItem(documentID string, lineItemID string, opts scheme.LineItemOptions) (*scheme.LineItem, error) {
+	m.record("UpdateLineItem", documentID, lineItemID, opts)
+	if m.UpdateLineItemFunc == nil {
+		return nil, ErrNotConfigured
+	}
+	return m.UpdateLineItemFunc(documentID, lineItemID, opts)
+}
+
+// DeleteLineItem calls DeleteLineItemFunc.
+func (m *Client) DeleteLineItem(documentID string, lineItemID string) error {
+	m.record("DeleteLineItem", documentID, lineItemID)
+	if m.DeleteLineItemFunc == nil {
+		return ErrNotConfigured
+	}
+	return m.DeleteLineItemFunc(documentID, lineItemID)
+}
+
+// GetTags calls GetTagsFunc.
+func (m *Client) GetTags(documentID string) (*scheme.Tags, error) {
+	m.record("GetTags", documentID)
+	if m.GetTagsFunc == nil {
+		return nil, ErrNotConfigured
+	}
+	return m.GetTagsFunc(documentID)
+}
+
+// GetGlobalTags calls GetGlobalTagsFunc.
+func (m *Client) GetGlobalTags() (*scheme.Tags, error) {
+	m.record("GetGlobalTags")
+	if m.GetGlobalTagsFunc == nil {
+		return nil, ErrNotConfigured
+	}
+	return m.GetGlobalTagsFunc()
+}
+
+// AddTag calls AddTagFunc.
+func (m *Client) AddTag(documentID string, opts scheme.TagOptions) (*scheme.Tag, error) {
+	m.record("AddTag", documentID, opts)
+	if m.AddTagFunc == nil {
+		return nil, ErrNotConfigured
+	}
+	return m.AddTagFunc(documentID, opts)
+}
+
+// DeleteTag calls DeleteTagFunc.
+func (m *Client) DeleteTag(documentID string, tagID string) error {
+	m.record("DeleteTag", documentID, tagID)
+	if m.DeleteTagFunc == nil {
+		return ErrNotConfigured
+	}
+	return m.DeleteTagFunc(documentID, tagID)
+}
+
+// DeleteGlobalTag calls DeleteGlobalTagFunc.
+func (m *Client) DeleteGlobalTag(tagID string) error {
+	m.record("DeleteGlobalTag", tagID)
+	if m.DeleteGlobalTagFunc == nil {
+		return ErrNotConfigured
+	}
+	return m.DeleteGlobalTagFunc(tagID)
+}
+
+// ProcessAnyDocumentUpload calls ProcessAnyDocumentUploadFunc.
+func (m *Client) ProcessAnyDocumentUpload(opts scheme.AnyDocumentUploadOptions) (*scheme.AnyDocument, error) {
+	m.record("ProcessAnyDocumentUpload", opts)
+	if m.ProcessAnyDocumentUploadFunc == nil {
+		return nil, ErrNotConfigured
+	}
+	return m.ProcessAnyDocumentUploadFunc(opts)
+}
+
+// ProcessDetailedAnyDocumentUpload calls ProcessDetailedAnyDocumentUploadFunc.
+func (m *Client) ProcessDetailedAnyDocumentUpload(opts scheme.AnyDocumentUploadOptions) (*scheme.AnyDocument, error) {
+	m.record("ProcessDetailedAnyDocumentUpload", opts)
+	if m.ProcessDetailedAnyDocumentUploadFunc == nil {
+		return nil, ErrNotConfigured
+	}
+	return m.ProcessDetailedAnyDocumentUploadFunc(opts)
+}
+
+// ProcessAnyDocumentURL calls ProcessAnyDocumentURLFunc.
+func (m *Client) ProcessAnyDocumentURL(opts scheme.AnyDocumentURLOptions) (*scheme.AnyDocument, error) {
+	m.record("ProcessAnyDocumentURL", opts)
+	if m.ProcessAnyDocumentURLFunc == nil {
+		return nil, ErrNotConfigured
+	}
+	return m.ProcessAnyDocumentURLFunc(opts)
+}
+
+// ProcessDetailedAnyDocumentURL calls ProcessDetailedAnyDocumentURLFunc.
+func (m *Client) ProcessDetailedAnyDocumentURL(opts scheme.AnyDocumentURLOptions) (*scheme.AnyDocument, error) {
+	m.record("ProcessDetailedAnyDocumentURL", opts)
+	if m.ProcessDetailedAnyDocumentURLFunc == nil {
+		return nil, ErrNotConfigured
+	}
+	return m.ProcessDetailedAnyDocumentURLFunc(opts)
+}
+
+// GetAnyDocument calls GetAnyDocumentFunc.
+func (m *Client) GetAnyDocument(documentID string, opts scheme.AnyDocumentGetOptions) (*scheme.AnyDocument, error) {
+	m.record("GetAnyDocument", documentID, opts)
+	if m.GetAnyDocumentFunc == nil {
+		return nil, ErrNotConfigured
+	}
+	return m.GetAnyDocumentFunc(documentID, opts)
+}
+
+// SearchAnyDocuments calls SearchAnyDocumentsFunc.
+func (m *Client) SearchAnyDocuments(opts scheme.AnyDocumentSearchOptions) (*scheme.AnyDocuments, error) {
+	m.record("SearchAnyDocuments", opts)
+	if m.SearchAnyDocumentsFunc == nil {
+		return nil, ErrNotConfigured
+	}
+	return m.SearchAnyDocumentsFunc(opts)
+}
+
+// DeleteAnyDocument calls DeleteAnyDocumentFunc.
+func (m *Client) DeleteAnyDocument(documentID string) error {
+	m.record("DeleteAnyDocument", documentID)
+	if m.DeleteAnyDocumentFunc == nil {
+		return ErrNotConfigured
+	}
+	return m.DeleteAnyDocumentFunc(documentID)
+}
+
+// ProcessBankStatementUpload calls ProcessBankStatementUploadFunc.
+func (m *Client) ProcessBankStatementUpload(opts scheme.BankStatementUploadOptions) (*scheme.BankStatement, error) {
+	m.record("ProcessBankStatementUpload", opts)
+	if m.ProcessBankStatementUploadFunc == nil {
+		return nil, ErrNotConfigured
+	}
+	return m.ProcessBankStatementUploadFunc(opts)
+}
+
+// ProcessBankStatementURL calls ProcessBankStatementURLFunc.
+func (m *Client) ProcessBankStatementURL(opts scheme.BankStatementURLOptions) (*scheme.BankStatement, error) {
+	m.record("ProcessBankStatementURL", opts)
+	if m.ProcessBankStatementURLFunc == nil {
+		return nil, ErrNotConfigured
+	}
+	return m.ProcessBankStatementURLFunc(opts)
+}
+
+// GetBankStatement calls GetBankStatementFunc.
+func (m *Client) GetBankStatement(documentID string, opts scheme.BankStatementGetOptions) (*scheme.BankStatement, error) {
+	m.record("GetBankStatement", documentID, opts)
+	if m.GetBankStatementFunc == nil {
+		return nil, ErrNotConfigured
+	}
+	return m.GetBankStatementFunc(documentID, opts)
+}
+
+// SearchBankStatements calls SearchBankStatementsFunc.
+func (m *Client) SearchBankStatements(opts scheme.BankStatementSearchOptions) (*scheme.BankStatements, error) {
+	m.record("SearchBankStatements", opts)
+	if m.SearchBankStatementsFunc == nil {
+		return nil, ErrNotConfigured
+	}
+	return m.SearchBankStatementsFunc(opts)
+}
+
+// DeleteBankStatement calls DeleteBankStatementFunc.
+func (m *Client) DeleteBankStatement(documentID string) error {
+	m.record("DeleteBankStatement", documentID)
+	if m.DeleteBankStatementFunc == nil {
+		return ErrNotConfigured
+	}
+	return m.DeleteBankStatementFunc(documentID)
+}
+
+// ProcessCheckUpload calls ProcessCheckUploadFunc.
+func (m *Client) ProcessCheckUpload(opts scheme.CheckUploadOptions) (*scheme.Check, error) {
+	m.record("ProcessCheckUpload", opts)
+	if m.ProcessCheckUploadFunc == nil {
+		return nil, ErrNotConfigured
+	}
+	return m.ProcessCheckUploadFunc(opts)
+}
+
+// ProcessCheckURL calls ProcessCheckURLFunc.
+func (m *Client) ProcessCheckURL(opts scheme.CheckURLOptions) (*scheme.Check, error) {
+	m.record("ProcessCheckURL", opts)
+	if m.ProcessCheckURLFunc == nil {
+		return nil, ErrNotConfigured
+	}
+	return m.ProcessCheckURLFunc(opts)
+}
+
+// GetCheck calls GetCheckFunc.
+func (m *Client) GetCheck(documentID string, opts scheme.CheckGetOptions) (*scheme.Check, error) {
+	m.record("GetCheck", documentID, opts)
+	if m.GetCheckFunc == nil {
+		return nil, ErrNotConfigured
+	}
+	return m.GetCheckFunc(documentID, opts)
+}
+
+// SearchChecks calls SearchChecksFunc.
+func (m *Client) SearchChecks(opts scheme.CheckSearchOptions) (*scheme.Checks, error) {
+	m.record("SearchChecks", opts)
+	if m.SearchChecksFunc == nil {
+		return nil, ErrNotConfigured
+	}
+	return m.SearchChecksFunc(opts)
+}
+
+// DeleteCheck calls DeleteCheckFunc.
+func (m *Client) DeleteCheck(documentID string) error {
+	m.record("DeleteCheck", documentID)
+	if m.DeleteCheckFunc == nil {
+		return ErrNotConfigured
+	}
+	return m.DeleteCheckFunc(documentID)
+}
+
+// ProcessW2Upload calls ProcessW2UploadFunc.
+func (m *Client) ProcessW2Upload(opts scheme.TaxFormUploadOptions) (*scheme.W2Form, error) {
+	m.record("ProcessW2Upload", opts)
+	if m.ProcessW2UploadFunc == nil {
+		return nil, ErrNotConfigured
+	}
+	return m.ProcessW2UploadFunc(opts)
+}
+
+// ProcessW2URL calls ProcessW2URLFunc.
+func (m *Client) ProcessW2URL(opts scheme.TaxFormURLOptions) (*scheme.W2Form, error) {
+	m.record("ProcessW2URL", opts)
+	if m.ProcessW2URLFunc == nil {
+		return nil, ErrNotConfigured
+	}
+	return m.ProcessW2URLFunc(opts)
+}
+
+// GetW2 calls GetW2Func.
+func (m *Client) GetW2(documentID string) (*scheme.W2Form, error) {
+	m.record("GetW2", documentID)
+	if m.GetW2Func == nil {
+		return nil, ErrNotConfigured
+	}
+	return m.GetW2Func(documentID)
+}
+
+// SearchW2s calls SearchW2sFunc.
+func (m *Client) SearchW2s(opts scheme.TaxFormSearchOptions) (*scheme.W2Forms, error) {
+	m.record("SearchW2s", opts)
+	if m.SearchW2sFunc == nil {
+		return nil, ErrNotConfigured
+	}
+	return m.SearchW2sFunc(opts)
+}
+
+// DeleteW2 calls DeleteW2Func.
+func (m *Client) DeleteW2(documentID string) error {
+	m.record("DeleteW2", documentID)
+	if m.DeleteW2Func == nil {
+		return ErrNotConfigured
+	}
+	return m.DeleteW2Func(documentID)
+}
+
+// ProcessW9Upload calls ProcessW9UploadFunc.
+func (m *Client) ProcessW9Upload(opts scheme.TaxFormUploadOptions) (*scheme.W9Form, error) {
+	m.record("ProcessW9Upload", opts)
+	if m.ProcessW9UploadFunc == nil {
+		return nil, ErrNotConfigured
+	}
+	return m.ProcessW9UploadFunc(opts)
+}
+
+// ProcessW9URL calls ProcessW9URLFunc.
+func (m *Client) ProcessW9URL(opts scheme.TaxFormURLOptions) (*scheme.W9Form, error) {
+	m.record("ProcessW9URL", opts)
+	if m.ProcessW9URLFunc == nil {
+		return nil, ErrNotConfigured
+	}
+	return m.ProcessW9URLFunc(opts)
+}
+
+// GetW9 calls GetW9Func.
+func (m *Client) GetW9(documentID string) (*scheme.W9Form, error) {
+	m.record("GetW9", documentID)
+	if m.GetW9Func == nil {
+		return nil, ErrNotConfigured
+	}
+	return m.GetW9Func(documentID)
+}
+
+// SearchW9s calls SearchW9sFunc.
+func (m *Client) SearchW9s(opts scheme.TaxFormSearchOptions) (*scheme.W9Forms, error) {
+	m.record("SearchW9s", opts)
+	if m.SearchW9sFunc == nil {
+		return nil, ErrNotConfigured
+	}
+	return m.SearchW9sFunc(opts)
+}
+
+// DeleteW9 calls DeleteW9Func.
+func (m *Client) DeleteW9(documentID string) error {
+	m.record("DeleteW9", documentID)
+	if m.DeleteW9Func == nil {
+		return ErrNotConfigured
+	}
+	return m.DeleteW9Func(documentID)
+}
+
+// ProcessW8Upload calls ProcessW8UploadFunc.
+func (m *Client) ProcessW8Upload(opts scheme.TaxFormUploadOptions) (*scheme.W8Form, error) {
+	m.record("ProcessW8Upload", opts)
+	if m.ProcessW8UploadFunc == nil {
+		return nil, ErrNotConfigured
+	}
+	return m.ProcessW8UploadFunc(opts)
+}
+
+// ProcessW8URL calls ProcessW8URLFunc.
+func (m *Client) ProcessW8URL(opts scheme.TaxFormURLOptions) (*scheme.W8Form, error) {
+	m.record("ProcessW8URL", opts)
+	if m.ProcessW8URLFunc == nil {
+		return nil, ErrNotConfigured
+	}
+	return m.ProcessW8URLFunc(opts)
+}
+
+// GetW8 calls GetW8Func.
+func (m *Client) GetW8(documentID string) (*scheme.W8Form, error) {
+	m.record("GetW8", documentID)
+	if m.GetW8Func == nil {
+		return nil, ErrNotConfigured
+	}
+	return m.GetW8Func(documentID)
+}
+
+// SearchW8s calls SearchW8sFunc.
+func (m *Client) SearchW8s(opts scheme.TaxFormSearchOptions) (*scheme.W8Forms, error) {
+	m.record("SearchW8s", opts)
+	if m.SearchW8sFunc == nil {
+		return nil, ErrNotConfigured
+	}
+	return m.SearchW8sFunc(opts)
+}
+
+// DeleteW8 calls DeleteW8Func.
+func (m *Client) DeleteW8(documentID string) error {
+	m.record("DeleteW8", documentID)
+	if m.DeleteW8Func == nil {
+		return ErrNotConfigured
+	}
+	return m.DeleteW8Func(documentID)
+}
+
+// ProcessBusinessCardUpload calls ProcessBusinessCardUploadFunc.
+func (m *Client) ProcessBusinessCardUpload(opts scheme.BusinessCardUploadOptions) (*scheme.BusinessCard, error) {
+	m.record("ProcessBusinessCardUpload", opts)
+	if m.ProcessBusinessCardUploadFunc == nil {
+		return nil, ErrNotConfigured
+	}
+	return m.ProcessBusinessCardUploadFunc(opts)
+}
+
+// ProcessBusinessCardURL calls ProcessBusinessCardURLFunc.
+func (m *Client) ProcessBusinessCardURL(opts scheme.BusinessCardURLOptions) (*scheme.BusinessCard, error) {
+	m.record("ProcessBusinessCardURL", opts)
+	if m.ProcessBusinessCardURLFunc == nil {
+		return nil, ErrNotConfigured
+	}
+	return m.ProcessBusinessCardURLFunc(opts)
+}
+
+// GetBusinessCard calls GetBusinessCardFunc.
+func (m *Client) GetBusinessCard(documentID string) (*scheme.BusinessCard, error) {
+	m.record("GetBusinessCard", documentID)
+	if m.GetBusinessCardFunc == nil {
+		return nil, ErrNotConfigured
+	}
+	return m.GetBusinessCardFunc(documentID)
+}
+
+// SearchBusinessCards calls SearchBusinessCardsFunc.
+func (m *Client) SearchBusinessCards(opts scheme.BusinessCardSearchOptions) (*scheme.BusinessCards, error) {
+	m.record("SearchBusinessCards", opts)
+	if m.SearchBusinessCardsFunc == nil {
+		return nil, ErrNotConfigured
+	}
+	return m.SearchBusinessCardsFunc(opts)
+}
+
+// DeleteBusinessCard calls DeleteBusinessCardFunc.
+func (m *Client) DeleteBusinessCard(documentID string) error {
+	m.record("DeleteBusinessCard", documentID)
+	if m.DeleteBusinessCardFunc == nil {
+		return ErrNotConfigured
+	}
+	return m.DeleteBusinessCardFunc(documentID)
+}
+
+// ClassifyDocumentUpload calls ClassifyDocumentUploadFunc.
+func (m *Client) ClassifyDocumentUpload(opts scheme.ClassifyUploadOptions) (*scheme.Classification, error) {
+	m.record("ClassifyDocumentUpload", opts)
+	if m.ClassifyDocumentUploadFunc == nil {
+		return nil, ErrNotConfigured
+	}
+	return m.ClassifyDocumentUploadFunc(opts)
+}
+
+// ClassifyDocumentURL calls ClassifyDocumentURLFunc.
+func (m *Client) ClassifyDocumentURL(opts scheme.ClassifyURLOptions) (*scheme.Classification, error) {
+	m.record("ClassifyDocumentURL", opts)
+	if m.ClassifyDocumentURLFunc == nil {
+		return nil, ErrNotConfigured
+	}
+	return m.ClassifyDocumentURLFunc(opts)
+}
+
+// ProcessSplitDocumentUpload calls ProcessSplitDocumentUploadFunc.
+func (m *Client) ProcessSplitDocumentUpload(opts scheme.DocumentUploadOptions) (*scheme.DocumentBatch, error) {
+	m.record("ProcessSplitDocumentUpload", opts)
+	if m.ProcessSplitDocumentUploadFunc == nil {
+		return nil, ErrNotConfigured
+	}
+	return m.ProcessSplitDocumentUploadFunc(opts)
+}
+
+// ProcessSplitDocumentURL calls ProcessSplitDocumentURLFunc.
+func (m *Client) ProcessSplitDocumentURL(opts scheme.DocumentURLOptions) (*scheme.DocumentBatch, error) {
+	m.record("ProcessSplitDocumentURL", opts)
+	if m.ProcessSplitDocumentURLFunc == nil {
+		return nil, ErrNotConfigured
+	}
+	return m.ProcessSplitDocumentURLFunc(opts)
+}
+
+// GetDocumentBatch calls GetDocumentBatchFunc.
+func (m *Client) GetDocumentBatch(batchID string) (*scheme.DocumentBatch, error) {
+	m.record("GetDocumentBatch", batchID)
+	if m.GetDocumentBatchFunc == nil {
+		return nil, ErrNotConfigured
+	}
+	return m.GetDocumentBatchFunc(batchID)
+}
+
+// WaitDocumentBatch calls WaitDocumentBatchFunc.
+func (m *Client) WaitDocumentBatch(ctx context.Context, batchID string, interval time.Duration) (*scheme.DocumentBatch, error) {
+	m.record("WaitDocumentBatch", ctx, batchID, interval)
+	if m.WaitDocumentBatchFunc == nil {
+		return nil, ErrNotConfigured
+	}
+	return m.WaitDocumentBatchFunc(ctx, batchID, interval)
+}
+
+// GetBatchDocuments calls GetBatchDocumentsFunc.
+func (m *Client) GetBatchDocuments(batchID string) ([]scheme.Document, error) {
+	m.record("GetBatchDocuments", batchID)
+	if m.GetBatchDocumentsFunc == nil {
+		return nil, ErrNotConfigured
+	}
+	return m.GetBatchDocumentsFunc(batchID)
+}
+
+// DownloadDocument calls DownloadDocumentFunc.
+func (m *Client) DownloadDocument(doc *scheme.Document, kind veryfi.DownloadKind, w io.Writer) (*veryfi.Download, error) {
+	m.record("DownloadDocument", doc, kind, w)
+	if m.DownloadDocumentFunc == nil {
+		return nil, ErrNotConfigured
+	}
+	return m.DownloadDocumentFunc(doc, kind, w)
+}
+
+// DownloadDocuments calls DownloadDocumentsFunc.
+func (m *Client) DownloadDocuments(docs []scheme.Document, dir string, opts veryfi.BulkDownloadOptions) ([]veryfi.DownloadedFile, error) {
+	m.record("DownloadDocuments", docs, dir, opts)
+	if m.DownloadDocumentsFunc == nil {
+		return nil, ErrNotConfigured
+	}
+	return m.DownloadDocumentsFunc(docs, dir, opts)
+}
@@ -0,0 +1,71 @@
+package mock
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/veryfi/veryfi-go/v4/veryfi"
+	"github.com/veryfi/veryfi-go/v4/veryfi/scheme"
+)
+
+// getTotal is a stand-in for application code that depends on veryfi.API.
+func getTotal(api veryfi.API, documentID string) (scheme.Amount, error) {
+	doc, err := api.GetDocument(documentID, scheme.DocumentGetOptions{})
+	if err != nil {
+		return scheme.Amount{}, err
+	}
+	return doc.Total, nil
+}
+
+func TestUnitMockClient_ProgrammedResponse(t *testing.T) {
+	m := &Client{
+		GetDocumentFunc: func(documentID string, opts scheme.DocumentGetOptions) (*scheme.Document, error) {
+			return &scheme.Document{Total: scheme.MustParseAmount("29.53")}, nil
+		},
+	}
+
+	total, err := getTotal(m, "36966934")
+	assert.NoError(t, err)
+	assert.Equal(t, "29.53", total.String())
+
+	calls := m.CallsTo("GetDocument")
+	assert.Len(t, calls, 1)
+	assert.Equal(t, []interface{}{"36966934", scheme.DocumentGetOptions{}}, calls[0].Args)
+}
+
+func TestUnitMockClient_NotConfigured(t *testing.T) {
+	m := &Client{}
+
+	tag, err := m.AddTag("1", scheme.TagOptions{Name: "foo"})
+	assert.Nil(t, tag)
+	assert.ErrorIs(t, err, ErrNotConfigured)
+	assert.ErrorIs(t, m.DeleteDocument("1"), ErrNotConfigured)
+
+	assert.Equal(t, []Call{
+		{Method: "AddTag", Args: []interface{}{"1", scheme.TagOptions{Name: "foo"}}},
+		{Method: "DeleteDocument", Args: []interface{}{"1"}},
+	}, m.Calls())
+
+	m.Reset()
+	assert.Empty(t, m.Calls())
+}
+
+func TestUnitMockClient_WithResponse(t *testing.T) {
+	m := &Client{}
+
+	// Calls made through WithResponse are recorded by the mock itself.
+	meta := &veryfi.ResponseMeta{}
+	assert.ErrorIs(t, m.WithResponse(meta).DeleteDocument("1"), ErrNotConfigured)
+	assert.Equal(t, []Call{
+		{Method: "WithResponse", Args: []interface{}{meta}},
+		{Method: "DeleteDocument", Args: []interface{}{"1"}},
+	}, m.Calls())
+
+	// The metadata can be faked too.
+	m.WithResponseFunc = func(meta *veryfi.ResponseMeta) veryfi.API {
+		meta.RequestID = "req-1"
+		return m
+	}
+	m.WithResponse(meta)
+	assert.Equal(t, "req-1", meta.RequestID)
+}
@@ -0,0 +1,244 @@
+package veryfi
+
+import (
+	"github.com/pkg/errors"
+	"github.com/veryfi/veryfi-go/v4/veryfi/scheme"
+)
+
+// ErrUnroutable is returned by a Router for a document whose type has no
+// processing API and no blueprint.
+var ErrUnroutable = errors.New("no processor for document type")
+
+// RouteKind describes the processing API a document was routed to, and so
+// which field of a RoutedDocument is set.
+type RouteKind string
+
+const (
+	RouteDocument      RouteKind = "document"
+	RouteBankStatement RouteKind = "bank_statement"
+	RouteCheck         RouteKind = "check"
+	RouteW2            RouteKind = "w2"
+	RouteW8            RouteKind = "w8"
+	RouteW9            RouteKind = "w9"
+	RouteBusinessCard  RouteKind = "business_card"
+	RouteAnyDocument   RouteKind = "any_document"
+)
+
+// routeKinds maps the document types with a dedicated processing API to it.
+var routeKinds = map[scheme.DocumentType]RouteKind{
+	scheme.DocumentTypeReceipt:       RouteDocument,
+	scheme.DocumentTypeInvoice:       RouteDocument,
+	scheme.DocumentTypeBankStatement: RouteBankStatement,
+	scheme.DocumentTypeCheck:         RouteCheck,
+	scheme.DocumentTypeW2:            RouteW2,
+	scheme.DocumentTypeW8:            RouteW8,
+	scheme.DocumentTypeW9:            RouteW9,
+	scheme.DocumentTypeBusinessCard:  RouteBusinessCard,
+}
+
+// RouterOptions describes the options of a Router.
+type RouterOptions struct {
+	// MinScore is the classification score below which a document is
+	// treated as scheme.DocumentTypeOther.
+	MinScore float64
+
+	// Blueprints maps document types to the blueprint they are processed
+	// with as any-documents. It takes precedence over the dedicated
+	// processing APIs, and may hold custom document types.
+	Blueprints map[scheme.DocumentType]string
+
+	// DefaultBlueprint is the blueprint used for any other document type.
+	// When empty, such documents fail with ErrUnroutable.
+	DefaultBlueprint string
+}
+
+// RouteOptions describes the options passed on to the processing API a
+// document is routed to.
+type RouteOptions struct {
+	FileName   string
+	ExternalID string
+}
+
+// RoutedDocument is the result of routing a document. Kind tells which one
+// of the processed document fields is set.
+type RoutedDocument struct {
+	Kind           RouteKind
+	Classification *scheme.Classification
+
+	Document      *scheme.Document
+	BankStatement *scheme.BankStatement
+	Check         *scheme.Check
+	W2            *scheme.W2Form
+	W8            *scheme.W8Form
+	W9            *scheme.W9Form
+	BusinessCard  *scheme.BusinessCard
+	AnyDocument   *scheme.AnyDocument
+}
+
+// Value returns the processed document field selected by Kind.
+func (r *RoutedDocument) Value() interface{} {
+	switch r.Kind {
+	case RouteDocument:
+		return r.Document
+	case RouteBankStatement:
+		return r.BankStatement
+	case RouteCheck:
+		return r.Check
+	case RouteW2:
+		return r.W2
+	case RouteW8:
+		return r.W8
+	case RouteW9:
+		return r.W9
+	case RouteBusinessCard:
+		return r.BusinessCard
+	case RouteAnyDocument:
+		return r.AnyDocument
+	default:
+		return nil
+	}
+}
+
+// Router classifies documents and then processes them with the matching
+// processing API.
+type Router struct {
+	api  API
+	opts RouterOptions
+}
+
+// NewRouter returns a Router using api.
+func NewRouter(api API, opts RouterOptions) *Router {
+	return &Router{api: api, opts: opts}
+}
+
+// RouteUpload classifies and processes a file.
+func (r *Router) RouteUpload(filePath string, opts RouteOptions) (*RoutedDocument, error) {
+	classification, err := r.api.ClassifyDocumentUpload(scheme.ClassifyUploadOptions{
+		FilePath:              filePath,
+		ClassifySharedOptions: scheme.ClassifySharedOptions{FileName: opts.FileName},
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "fail to classify document")
+	}
+
+	return r.route(classification, &source{path: filePath}, opts)
+}
+
+// RouteURL classifies and processes a file using URL.
+func (r *Router) RouteURL(fileURL string, opts RouteOptions) (*RoutedDocument, error) {
+	classification, err := r.api.ClassifyDocumentURL(scheme.ClassifyURLOptions{
+		FileURL:               fileURL,
+		ClassifySharedOptions: scheme.ClassifySharedOptions{FileName: opts.FileName},
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "fail to classify document")
+	}
+
+	return r.route(classification, &source{url: fileURL}, opts)
+}
+
+// source is the file being routed, either a local path or a URL.
+type source struct {
+	path string
+	url  string
+}
+
+// resolve returns the route kind of a classification and, for any-documents,
+// the blueprint to use.
+func (r *Router) resolve(c *scheme.Classification) (RouteKind, string, error) {
+	docType := c.DocumentType.Value
+	if c.DocumentType.Score < r.opts.MinScore {
+		docType = scheme.DocumentTypeOther
+	}
+	if blueprint, ok := r.opts.Blueprints[docType]; ok {
+		return RouteAnyDocument, blueprint, nil
+	}
+	if kind, ok := routeKinds[docType]; ok {
+		return kind, "", nil
+	}
+	if r.opts.DefaultBlueprint != "" {
+		return RouteAnyDocument, r.opts.DefaultBlueprint, nil
+	}
+
+	return "", "", errors.Wrapf(ErrUnroutable, "%q (score %.2f)", c.DocumentType.Value, c.DocumentType.Score)
+}
+
+// route processes src with the API matching its classification.
+func (r *Router) route(c *scheme.Classification, src *source, opts RouteOptions) (*RoutedDocument, error) {
+	kind, blueprint, err := r.resolve(c)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &RoutedDocument{Kind: kind, Classification: c}
+	upload := src.path != ""
+	switch kind {
+	case RouteDocument:
+		shared := scheme.DocumentSharedOptions{FileName: opts.FileName, ExternalID: opts.ExternalID}
+		if upload {
+			out.Document, err = r.api.ProcessDocumentUpload(scheme.DocumentUploadOptions{FilePath: src.path, DocumentSharedOptions: shared})
+		} else {
+			out.Document, err = r.api.ProcessDocumentURL(scheme.DocumentURLOptions{FileURL: src.url, DocumentSharedOptions: shared})
+		}
+	case RouteBankStatement:
+		shared := scheme.BankStatementSharedOptions{FileName: opts.FileName, ExternalID: opts.ExternalID}
+		if upload {
+			out.BankStatement, err = r.api.ProcessBankStatementUpload(scheme.BankStatementUploadOptions{FilePath: src.path, BankStatementSharedOptions: shared})
+		} else {
+			out.BankStatement, err = r.api.ProcessBankStatementURL(scheme.BankStatementURLOptions{FileURL: src.url, BankStatementSharedOptions: shared})
+		}
+	case RouteCheck:
+		shared := scheme.CheckSharedOptions{FileName: opts.FileName, ExternalID: opts.ExternalID}
+		if upload {
+			out.Check, err = r.api.ProcessCheckUpload(scheme.CheckUploadOptions{FilePath: src.path, CheckSharedOptions: shared})
+		} else {
+			out.Check, err = r.api.ProcessCheckURL(scheme.CheckURLOptions{FileURL: src.url, CheckSharedOptions: shared})
+		}
+	case RouteW2, RouteW8, RouteW9:
+		err = r.routeTaxForm(out, src, scheme.TaxFormSharedOptions{FileName: opts.FileName, ExternalID: opts.ExternalID})
+	case RouteBusinessCard:
+		shared := scheme.BusinessCardSharedOptions{FileName: opts.FileName, ExternalID: opts.ExternalID}
+		if upload {
+			out.BusinessCard, err = r.api.ProcessBusinessCardUpload(scheme.BusinessCardUploadOptions{FilePath: src.path, BusinessCardSharedOptions: shared})
+		} else {
+			out.BusinessCard, err = r.api.ProcessBusinessCardURL(scheme.BusinessCardURLOptions{FileURL: src.url, BusinessCardSharedOptions: shared})
+		}
+	case RouteAnyDocument:
+		shared := scheme.AnyDocumentSharedOptions{BlueprintName: blueprint, FileName: opts.FileName, ExternalID: opts.ExternalID}
+		if upload {
+			out.AnyDocument, err = r.api.ProcessAnyDocumentUpload(scheme.AnyDocumentUploadOptions{FilePath: src.path, AnyDocumentSharedOptions: shared})
+		} else {
+			out.AnyDocument, err = r.api.ProcessAnyDocumentURL(scheme.AnyDocumentURLOptions{FileURL: src.url, AnyDocumentSharedOptions: shared})
+		}
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "fail to process %s", kind)
+	}
+
+	return out, nil
+}
+
+// routeTaxForm processes src with the tax form API selected by out.Kind.
+func (r *Router) routeTaxForm(out *RoutedDocument, src *source, shared scheme.TaxFormSharedOptions) error {
+	uploadOpts := scheme.TaxFormUploadOptions{FilePath: src.path, TaxFormSharedOptions: shared}
+	urlOpts := scheme.TaxFormURLOptions{FileURL: src.url, TaxFormSharedOptions: shared}
+	upload := src.path != ""
+
+	var err error
+	switch {
+	case out.Kind == RouteW2 && upload:
+		out.W2, err = r.api.ProcessW2Upload(uploadOpts)
+	case out.Kind == RouteW2:
+		out.W2, err = r.api.ProcessW2URL(urlOpts)
+	case out.Kind == RouteW8 && upload:
+		out.W8, err = r.api.ProcessW8Upload(uploadOpts)
+	case out.Kind == RouteW8:
+		out.W8, err = r.api.ProcessW8URL(urlOpts)
+	case out.Kind == RouteW9 && upload:
+		out.W9, err = r.api.ProcessW9Upload(uploadOpts)
+	case out.Kind == RouteW9:
+		out.W9, err = r.api.ProcessW9URL(urlOpts)
+	}
+
+	return err
+}
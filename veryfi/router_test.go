@@ -0,0 +1,138 @@
+package veryfi_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/veryfi/veryfi-go/v4/veryfi"
+	"github.com/veryfi/veryfi-go/v4/veryfi/mock"
+	"github.com/veryfi/veryfi-go/v4/veryfi/scheme"
+)
+
+// classifyAs returns a mock whose classify calls report docType with score.
+func classifyAs(docType scheme.DocumentType, score float64) *mock.Client {
+	classification := &scheme.Classification{
+		ID:           1,
+		DocumentType: scheme.ClassifiedDocument{Value: docType, Score: score},
+	}
+	return &mock.Client{
+		ClassifyDocumentUploadFunc: func(opts scheme.ClassifyUploadOptions) (*scheme.Classification, error) {
+			return classification, nil
+		},
+		ClassifyDocumentURLFunc: func(opts scheme.ClassifyURLOptions) (*scheme.Classification, error) {
+			return classification, nil
+		},
+	}
+}
+
+func TestUnitRouter_Upload(t *testing.T) {
+	api := classifyAs(scheme.DocumentTypeBankStatement, 0.97)
+	api.ProcessBankStatementUploadFunc = func(opts scheme.BankStatementUploadOptions) (*scheme.BankStatement, error) {
+		return &scheme.BankStatement{ID: 7, ExternalID: opts.ExternalID}, nil
+	}
+
+	routed, err := veryfi.NewRouter(api, veryfi.RouterOptions{}).RouteUpload("statement.pdf", veryfi.RouteOptions{ExternalID: "mail-1"})
+	assert.NoError(t, err)
+	assert.Equal(t, veryfi.RouteBankStatement, routed.Kind)
+	assert.Equal(t, scheme.DocumentTypeBankStatement, routed.Classification.DocumentType.Value)
+	assert.Equal(t, &scheme.BankStatement{ID: 7, ExternalID: "mail-1"}, routed.BankStatement)
+	assert.Equal(t, routed.BankStatement, routed.Value())
+	assert.Nil(t, routed.Document)
+
+	calls := api.Calls()
+	assert.Len(t, calls, 2)
+	assert.Equal(t, "ClassifyDocumentUpload", calls[0].Method)
+	assert.Equal(t, "statement.pdf", calls[1].Args[0].(scheme.BankStatementUploadOptions).FilePath)
+}
+
+func TestUnitRouter_URL(t *testing.T) {
+	tests := []struct {
+		docType scheme.DocumentType
+		kind    veryfi.RouteKind
+		method  string
+	}{
+		{scheme.DocumentTypeReceipt, veryfi.RouteDocument, "ProcessDocumentURL"},
+		{scheme.DocumentTypeInvoice, veryfi.RouteDocument, "ProcessDocumentURL"},
+		{scheme.DocumentTypeCheck, veryfi.RouteCheck, "ProcessCheckURL"},
+		{scheme.DocumentTypeW2, veryfi.RouteW2, "ProcessW2URL"},
+		{scheme.DocumentTypeW8, veryfi.RouteW8, "ProcessW8URL"},
+		{scheme.DocumentTypeW9, veryfi.RouteW9, "ProcessW9URL"},
+		{scheme.DocumentTypeBusinessCard, veryfi.RouteBusinessCard, "ProcessBusinessCardURL"},
+	}
+	for _, tt := range tests {
+		api := classifyAs(tt.docType, 0.9)
+		api.ProcessDocumentURLFunc = func(opts scheme.DocumentURLOptions) (*scheme.Document, error) {
+			return &scheme.Document{}, nil
+		}
+		api.ProcessCheckURLFunc = func(opts scheme.CheckURLOptions) (*scheme.Check, error) {
+			return &scheme.Check{}, nil
+		}
+		api.ProcessW2URLFunc = func(opts scheme.TaxFormURLOptions) (*scheme.W2Form, error) {
+			return &scheme.W2Form{}, nil
+		}
+		api.ProcessW8URLFunc = func(opts scheme.TaxFormURLOptions) (*scheme.W8Form, error) {
+			return &scheme.W8Form{}, nil
+		}
+		api.ProcessW9URLFunc = func(opts scheme.TaxFormURLOptions) (*scheme.W9Form, error) {
+			return &scheme.W9Form{}, nil
+		}
+		api.ProcessBusinessCardURLFunc = func(opts scheme.BusinessCardURLOptions) (*scheme.BusinessCard, error) {
+			return &scheme.BusinessCard{}, nil
+		}
+
+		routed, err := veryfi.NewRouter(api, veryfi.RouterOptions{}).RouteURL("https://example.com/doc.pdf", veryfi.RouteOptions{})
+		assert.NoError(t, err, tt.docType)
+		assert.Equal(t, tt.kind, routed.Kind, tt.docType)
+		assert.NotNil(t, routed.Value(), tt.docType)
+		assert.Len(t, api.CallsTo(tt.method), 1, tt.docType)
+	}
+}
+
+func TestUnitRouter_AnyDocument(t *testing.T) {
+	api := classifyAs("drivers_license", 0.99)
+	api.ProcessAnyDocumentURLFunc = func(opts scheme.AnyDocumentURLOptions) (*scheme.AnyDocument, error) {
+		return &scheme.AnyDocument{BlueprintName: opts.BlueprintName}, nil
+	}
+
+	// A custom type without a blueprint cannot be routed.
+	_, err := veryfi.NewRouter(api, veryfi.RouterOptions{}).RouteURL("https://example.com/id.png", veryfi.RouteOptions{})
+	assert.True(t, errors.Is(err, veryfi.ErrUnroutable))
+	assert.EqualError(t, err, `"drivers_license" (score 0.99): no processor for document type`)
+
+	router := veryfi.NewRouter(api, veryfi.RouterOptions{
+		Blueprints: map[scheme.DocumentType]string{"drivers_license": "us_driver_license"},
+	})
+	routed, err := router.RouteURL("https://example.com/id.png", veryfi.RouteOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, veryfi.RouteAnyDocument, routed.Kind)
+	assert.Equal(t, "us_driver_license", routed.AnyDocument.BlueprintName)
+
+	// Blueprints take precedence over dedicated APIs, and low scores fall
+	// back to the default blueprint.
+	api = classifyAs(scheme.DocumentTypeReceipt, 0.4)
+	api.ProcessAnyDocumentUploadFunc = func(opts scheme.AnyDocumentUploadOptions) (*scheme.AnyDocument, error) {
+		return &scheme.AnyDocument{BlueprintName: opts.BlueprintName}, nil
+	}
+	router = veryfi.NewRouter(api, veryfi.RouterOptions{MinScore: 0.5, DefaultBlueprint: "generic"})
+	routed, err = router.RouteUpload("scan.png", veryfi.RouteOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, "generic", routed.AnyDocument.BlueprintName)
+
+	router = veryfi.NewRouter(api, veryfi.RouterOptions{
+		Blueprints: map[scheme.DocumentType]string{scheme.DocumentTypeReceipt: "custom_receipt"},
+	})
+	routed, err = router.RouteUpload("scan.png", veryfi.RouteOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, "custom_receipt", routed.AnyDocument.BlueprintName)
+}
+
+func TestUnitRouter_Errors(t *testing.T) {
+	api := &mock.Client{}
+	_, err := veryfi.NewRouter(api, veryfi.RouterOptions{}).RouteUpload("scan.png", veryfi.RouteOptions{})
+	assert.EqualError(t, err, "fail to classify document: mock: method is not configured")
+
+	api = classifyAs(scheme.DocumentTypeCheck, 0.9)
+	_, err = veryfi.NewRouter(api, veryfi.RouterOptions{}).RouteUpload("scan.png", veryfi.RouteOptions{})
+	assert.EqualError(t, err, "fail to process check: mock: method is not configured")
+}
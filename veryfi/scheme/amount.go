@@ -0,0 +1,357 @@
+package scheme
+
+import (
+	"bytes"
+	"encoding/json"
+	"math"
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Amount is an exact decimal amount, such as a total or a price. Unlike
+// float64, adding up amounts never introduces rounding errors. The zero value
+// is 0.
+//
+// Amounts are kept normalized, so two amounts are equal with == if and only
+// if they represent the same number, e.g. 1.5 and 1.50. They have no upper
+// bound, so arithmetic never overflows.
+type Amount struct {
+	// value is the coefficient, such that the amount is value * 10^-scale,
+	// when it fits in an int64.
+	value int64
+
+	// big holds the decimal digits of the coefficient when it does not fit in
+	// an int64, and is empty otherwise.
+	big string
+
+	// scale is the number of decimal places, never negative.
+	scale int32
+}
+
+// NewAmount returns the amount value * 10^-scale, e.g. NewAmount(2953, 2) is
+// 29.53.
+func NewAmount(value int64, scale int32) Amount {
+	if scale < 0 {
+		return fromBig(new(big.Int).Mul(big.NewInt(value), pow10(-scale)), 0)
+	}
+
+	return Amount{value: value, scale: scale}.normalize()
+}
+
+// AmountFromInt returns the amount v.
+func AmountFromInt(v int64) Amount {
+	return Amount{value: v}
+}
+
+// AmountFromFloat returns the amount closest to f with the fewest decimal
+// places, i.e. the number strconv prints for it, so 0.1 becomes exactly 0.1.
+// It fails if f is NaN or infinite.
+func AmountFromFloat(f float64) (Amount, error) {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return Amount{}, errors.Errorf("invalid amount %v", f)
+	}
+
+	return ParseAmount(strconv.FormatFloat(f, 'f', -1, 64))
+}
+
+// MustAmountFromFloat is like AmountFromFloat but panics if f is NaN or
+// infinite. It is meant for constants and tests.
+func MustAmountFromFloat(f float64) Amount {
+	a, err := AmountFromFloat(f)
+	if err != nil {
+		panic(err)
+	}
+
+	return a
+}
+
+// decimalPattern matches a decimal literal, optionally with an exponent.
+var decimalPattern = regexp.MustCompile(`^[+-]?(\d+\.?\d*|\.\d+)([eE][+-]?\d+)?$`)
+
+// ParseAmount parses a decimal number such as "29.53", "-0.5" or "1e3".
+// Other notations, such as "0x10" or "1/3", are rejected.
+func ParseAmount(s string) (Amount, error) {
+	s = strings.TrimSpace(s)
+	if !decimalPattern.MatchString(s) {
+		return Amount{}, errors.Errorf("invalid amount %q", s)
+	}
+	r, ok := new(big.Rat).SetString(s)
+	if !ok {
+		return Amount{}, errors.Errorf("invalid amount %q", s)
+	}
+
+	// Find the smallest scale at which the number is an integer. A decimal
+	// string always has a power of ten denominator, once reduced 2^a * 5^b.
+	scale := int32(0)
+	num, denom := new(big.Int).Set(r.Num()), new(big.Int).Set(r.Denom())
+	ten := big.NewInt(10)
+	for denom.Cmp(big.NewInt(1)) != 0 {
+		if scale > 36 {
+			return Amount{}, errors.Errorf("invalid amount %q: too many decimal places", s)
+		}
+		num.Mul(num, ten)
+		scale++
+		g := new(big.Int).GCD(nil, nil, num, denom)
+		num.Quo(num, g)
+		denom.Quo(denom, g)
+	}
+
+	return fromBig(num, scale), nil
+}
+
+// MustParseAmount is like ParseAmount but panics if s is invalid. It is meant
+// for constants and tests.
+func MustParseAmount(s string) Amount {
+	a, err := ParseAmount(s)
+	if err != nil {
+		panic(err)
+	}
+
+	return a
+}
+
+// SumAmounts returns the sum of amounts.
+func SumAmounts(amounts ...Amount) Amount {
+	sum := Amount{}
+	for _, a := range amounts {
+		sum = sum.Add(a)
+	}
+
+	return sum
+}
+
+// Add returns a + b.
+func (a Amount) Add(b Amount) Amount {
+	scale := max(a.scale, b.scale)
+	return fromBig(new(big.Int).Add(a.rescaled(scale), b.rescaled(scale)), scale)
+}
+
+// Sub returns a - b.
+func (a Amount) Sub(b Amount) Amount {
+	scale := max(a.scale, b.scale)
+	return fromBig(new(big.Int).Sub(a.rescaled(scale), b.rescaled(scale)), scale)
+}
+
+// Mul returns a * b, e.g. a price times a quantity. Use Round to bring the
+// result back to a currency's precision.
+func (a Amount) Mul(b Amount) Amount {
+	return fromBig(new(big.Int).Mul(a.coef(), b.coef()), a.scale+b.scale)
+}
+
+// Div returns a / b rounded half away from zero to places decimal places. It
+// panics if b is zero.
+func (a Amount) Div(b Amount, places int32) Amount {
+	places = max(places, 0)
+	if b.IsZero() {
+		panic("scheme: amount division by zero")
+	}
+	r := new(big.Rat).Quo(a.rat(), b.rat())
+
+	return roundRat(r, places)
+}
+
+// Neg returns -a.
+func (a Amount) Neg() Amount {
+	return fromBig(new(big.Int).Neg(a.coef()), a.scale)
+}
+
+// Abs returns the absolute value of a.
+func (a Amount) Abs() Amount {
+	if a.Sign() < 0 {
+		return a.Neg()
+	}
+
+	return a
+}
+
+// Round returns a rounded half away from zero to places decimal places, e.g.
+// 2.345 rounded to 2 places is 2.35.
+func (a Amount) Round(places int32) Amount {
+	places = max(places, 0)
+	if places >= a.scale {
+		return a
+	}
+
+	return roundRat(a.rat(), places)
+}
+
+// Cmp returns -1, 0 or +1 depending on whether a is less than, equal to or
+// greater than b.
+func (a Amount) Cmp(b Amount) int {
+	scale := max(a.scale, b.scale)
+	return a.rescaled(scale).Cmp(b.rescaled(scale))
+}
+
+// Sign returns -1, 0 or +1 depending on the sign of a.
+func (a Amount) Sign() int {
+	switch {
+	case a.big != "":
+		return a.coef().Sign()
+	case a.value < 0:
+		return -1
+	case a.value > 0:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// IsZero reports whether a is 0.
+func (a Amount) IsZero() bool {
+	return a.value == 0 && a.big == ""
+}
+
+// Scale returns the number of decimal places of a.
+func (a Amount) Scale() int32 {
+	return a.scale
+}
+
+// Float64 returns the float64 closest to a.
+func (a Amount) Float64() float64 {
+	f, _ := a.rat().Float64()
+	return f
+}
+
+// String returns a with as few decimal places as needed, e.g. "29.5".
+func (a Amount) String() string {
+	return a.StringFixed(a.scale)
+}
+
+// StringFixed returns a rounded or padded to places decimal places, e.g.
+// "29.50" for 2 places.
+func (a Amount) StringFixed(places int32) string {
+	places = max(places, 0)
+	r := a.Round(places)
+	digits := new(big.Int).Abs(r.rescaled(places)).String()
+	if places > 0 {
+		if pad := int(places) + 1 - len(digits); pad > 0 {
+			digits = strings.Repeat("0", pad) + digits
+		}
+		digits = digits[:len(digits)-int(places)] + "." + digits[len(digits)-int(places):]
+	}
+	if r.Sign() < 0 {
+		digits = "-" + digits
+	}
+
+	return digits
+}
+
+// MarshalJSON implements json.Marshaler. Amounts are encoded as JSON numbers.
+func (a Amount) MarshalJSON() ([]byte, error) {
+	return []byte(a.String()), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler. It accepts JSON numbers, numeric
+// strings and null, which leaves the amount unchanged. Other values fail with
+// a *json.UnmarshalTypeError, as values of the wrong type do for the built-in
+// types.
+func (a *Amount) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+	text := string(data)
+	if len(data) > 0 && data[0] == '"' {
+		s, err := strconv.Unquote(text)
+		if err != nil {
+			return errors.Wrap(err, "fail to decode amount")
+		}
+		if s == "" {
+			*a = Amount{}
+			return nil
+		}
+		text = s
+	}
+
+	parsed, err := ParseAmount(text)
+	if err != nil {
+		return &json.UnmarshalTypeError{Value: jsonType(data), Type: amountType}
+	}
+	*a = parsed
+
+	return nil
+}
+
+// normalize strips trailing zeros from the coefficient of a, which fits in an
+// int64.
+func (a Amount) normalize() Amount {
+	if a.value == 0 {
+		return Amount{}
+	}
+	for a.scale > 0 && a.value%10 == 0 {
+		a.value /= 10
+		a.scale--
+	}
+
+	return a
+}
+
+// coef returns the coefficient of a.
+func (a Amount) coef() *big.Int {
+	if a.big == "" {
+		return big.NewInt(a.value)
+	}
+	v, _ := new(big.Int).SetString(a.big, 10)
+
+	return v
+}
+
+// rescaled returns the coefficient of a at another scale.
+func (a Amount) rescaled(scale int32) *big.Int {
+	v := a.coef()
+	if scale > a.scale {
+		v.Mul(v, pow10(scale-a.scale))
+	} else if scale < a.scale {
+		v.Quo(v, pow10(a.scale-scale))
+	}
+
+	return v
+}
+
+// rat returns a as a big.Rat.
+func (a Amount) rat() *big.Rat {
+	return new(big.Rat).SetFrac(a.coef(), pow10(a.scale))
+}
+
+// fromBig returns the amount v * 10^-scale.
+func fromBig(v *big.Int, scale int32) Amount {
+	ten := big.NewInt(10)
+	mod := new(big.Int)
+	for scale > 0 && v.Sign() != 0 {
+		q, m := new(big.Int).QuoRem(v, ten, mod)
+		if m.Sign() != 0 {
+			break
+		}
+		v = q
+		scale--
+	}
+	if !v.IsInt64() {
+		return Amount{big: v.String(), scale: scale}
+	}
+
+	return Amount{value: v.Int64(), scale: scale}.normalize()
+}
+
+// roundRat rounds r half away from zero to places decimal places.
+func roundRat(r *big.Rat, places int32) Amount {
+	scaled := new(big.Rat).Mul(r, new(big.Rat).SetInt(pow10(places)))
+	num := new(big.Int).Abs(scaled.Num())
+	q, m := new(big.Int).QuoRem(num, scaled.Denom(), new(big.Int))
+	if new(big.Int).Mul(m, big.NewInt(2)).Cmp(scaled.Denom()) >= 0 {
+		q.Add(q, big.NewInt(1))
+	}
+	if scaled.Sign() < 0 {
+		q.Neg(q)
+	}
+
+	return fromBig(q, places)
+}
+
+// pow10 returns 10^n.
+func pow10(n int32) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
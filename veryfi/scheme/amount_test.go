@@ -0,0 +1,136 @@
+package scheme
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitParseAmount(t *testing.T) {
+	for in, want := range map[string]string{
+		"29.53":  "29.53",
+		"29.50":  "29.5",
+		"-0.5":   "-0.5",
+		"1e3":    "1000",
+		"  7 ":   "7",
+		"0.000":  "0",
+		"1.2e-2": "0.012",
+		"1e40":   "10000000000000000000000000000000000000000",
+		"+.5":    "0.5",
+		"3.":     "3",
+	} {
+		a, err := ParseAmount(in)
+		assert.NoError(t, err, in)
+		assert.Equal(t, want, a.String(), in)
+	}
+
+	for _, in := range []string{"", "abc", "1/3", "1e-40", "0x10", "0b101", "0o17", "1_000", "0x1p-2", ".", "Inf", "NaN"} {
+		_, err := ParseAmount(in)
+		assert.Error(t, err, in)
+	}
+
+	// Amounts are normalized, so == compares values.
+	assert.Equal(t, MustParseAmount("1.50"), NewAmount(15, 1))
+	assert.Equal(t, NewAmount(3, -2), AmountFromInt(300))
+	assert.Equal(t, MustParseAmount("0.1"), MustAmountFromFloat(0.1))
+	assert.Equal(t, MustParseAmount("100000000000000000000"), MustAmountFromFloat(1e20))
+	for _, f := range []float64{math.NaN(), math.Inf(1), math.Inf(-1)} {
+		_, err := AmountFromFloat(f)
+		assert.Error(t, err, f)
+	}
+}
+
+func TestUnitAmount_Large(t *testing.T) {
+	largest := AmountFromInt(math.MaxInt64)
+	sum := largest.Add(largest)
+	assert.Equal(t, "18446744073709551614", sum.String())
+	assert.Equal(t, largest, sum.Sub(largest))
+	assert.Equal(t, MustParseAmount("18446744073709551614"), sum)
+	assert.Equal(t, "85070591730234615847396907784232501249", largest.Mul(largest).String())
+	assert.Equal(t, "-9223372036854775808.5", AmountFromInt(math.MinInt64).Sub(MustParseAmount("0.5")).String())
+	assert.Equal(t, 1, sum.Cmp(largest))
+	assert.Equal(t, -1, sum.Neg().Sign())
+	assert.Equal(t, sum, sum.Neg().Abs())
+	assert.Equal(t, "9223372036854775807.00", largest.StringFixed(2))
+	assert.Equal(t, 1.8446744073709552e19, sum.Float64())
+
+	out, err := json.Marshal(sum)
+	assert.NoError(t, err)
+	assert.Equal(t, "18446744073709551614", string(out))
+}
+
+func TestUnitAmount_Arithmetic(t *testing.T) {
+	a, b := MustParseAmount("0.1"), MustParseAmount("0.2")
+	assert.Equal(t, MustParseAmount("0.3"), a.Add(b))
+	assert.Equal(t, MustParseAmount("-0.1"), a.Sub(b))
+	assert.Equal(t, MustParseAmount("0.02"), a.Mul(b))
+	assert.Equal(t, MustParseAmount("0.3"), SumAmounts(a, b))
+	assert.Equal(t, MustParseAmount("0.33"), AmountFromInt(1).Div(AmountFromInt(3), 2))
+	assert.Equal(t, MustParseAmount("-0.67"), AmountFromInt(-2).Div(AmountFromInt(3), 2))
+	assert.Panics(t, func() { a.Div(Amount{}, 2) })
+
+	assert.Equal(t, MustParseAmount("2.35"), MustParseAmount("2.345").Round(2))
+	assert.Equal(t, MustParseAmount("-2.35"), MustParseAmount("-2.345").Round(2))
+	assert.Equal(t, MustParseAmount("2.3"), MustParseAmount("2.3").Round(2))
+	assert.Equal(t, MustParseAmount("1.5"), MustParseAmount("-1.5").Abs())
+
+	assert.Equal(t, -1, a.Cmp(b))
+	assert.Equal(t, 0, a.Cmp(MustParseAmount("0.10")))
+	assert.Equal(t, 1, b.Sign())
+	assert.True(t, Amount{}.IsZero())
+	assert.Equal(t, 0.3, a.Add(b).Float64())
+}
+
+func TestUnitAmount_StringFixed(t *testing.T) {
+	assert.Equal(t, "29.50", MustParseAmount("29.5").StringFixed(2))
+	assert.Equal(t, "0.05", MustParseAmount("0.05").StringFixed(2))
+	assert.Equal(t, "-0.50", MustParseAmount("-0.5").StringFixed(2))
+	assert.Equal(t, "30", MustParseAmount("29.5").StringFixed(0))
+	assert.Equal(t, "0.00", Amount{}.StringFixed(2))
+}
+
+func TestUnitAmount_JSON(t *testing.T) {
+	var doc struct {
+		Total    Amount  `json:"total"`
+		Tax      Amount  `json:"tax"`
+		Tip      Amount  `json:"tip"`
+		Discount Amount  `json:"discount"`
+		Price    *Amount `json:"price,omitempty"`
+	}
+	err := json.Unmarshal([]byte(`{"total": 29.53, "tax": "1.93", "tip": null, "discount": ""}`), &doc)
+	assert.NoError(t, err)
+	assert.Equal(t, MustParseAmount("29.53"), doc.Total)
+	assert.Equal(t, MustParseAmount("1.93"), doc.Tax)
+	assert.True(t, doc.Tip.IsZero())
+	assert.True(t, doc.Discount.IsZero())
+
+	out, err := json.Marshal(doc)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"total": 29.53, "tax": 1.93, "tip": 0, "discount": 0}`, string(out))
+
+	// Values of the wrong type fail like those of the built-in types.
+	var typeErr *json.UnmarshalTypeError
+	for _, in := range []string{`"abc"`, `true`, `{}`, `[1]`} {
+		var a Amount
+		assert.ErrorAs(t, json.Unmarshal([]byte(in), &a), &typeErr, in)
+	}
+	assert.ErrorAs(t, json.Unmarshal([]byte(`{"total": "abc"}`), &doc), &typeErr)
+}
+
+func TestUnitMoney(t *testing.T) {
+	doc := &Document{CurrencyCode: "USD", Subtotal: MustParseAmount("27.6"), Tax: MustParseAmount("1.93")}
+
+	total, err := doc.Money(doc.Subtotal).Add(doc.Money(doc.Tax))
+	assert.NoError(t, err)
+	assert.Equal(t, "29.53 USD", total.String())
+	assert.Equal(t, "27.60 USD", doc.Money(doc.Subtotal).String())
+
+	_, err = total.Sub(Money{Amount: AmountFromInt(1), Currency: "EUR"})
+	assert.EqualError(t, err, "can not subtract EUR from USD")
+
+	assert.Equal(t, "1235 JPY", Money{Amount: MustParseAmount("1234.5"), Currency: "JPY"}.Round().String())
+	assert.Equal(t, "1.235 KWD", Money{Amount: MustParseAmount("1.2345"), Currency: "KWD"}.Round().String())
+	assert.Equal(t, int32(2), CurrencyDigits("usd"))
+}
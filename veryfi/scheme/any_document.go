@@ -0,0 +1,240 @@
+package scheme
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// AnyDocumentUploadOptions describes the query parameters to process a file
+// with a blueprint.
+type AnyDocumentUploadOptions struct {
+	FilePath string
+
+	AnyDocumentSharedOptions
+}
+
+// AnyDocumentUploadBase64Options describes the query parameters to process a
+// Base64 encoded file with a blueprint.
+type AnyDocumentUploadBase64Options struct {
+	FileData string `json:"file_data,omitempty"`
+
+	AnyDocumentSharedOptions
+}
+
+// AnyDocumentURLOptions describes the query parameters to process a file using
+// a URL with a blueprint.
+type AnyDocumentURLOptions struct {
+	FileURL  string   `json:"file_url,omitempty"`
+	FileURLS []string `json:"file_urls,omitempty"`
+
+	AnyDocumentSharedOptions
+}
+
+// AnyDocumentSharedOptions describes the shared query parameters among the
+// any-documents processing API.
+type AnyDocumentSharedOptions struct {
+	BlueprintName     string `json:"blueprint_name"`
+	FileName          string `json:"file_name,omitempty"`
+	MaxPagesToProcess *int   `json:"max_pages_to_process,omitempty"`
+	ExternalID        string `json:"external_id,omitempty"`
+	Async             bool   `json:"async,omitempty"`
+	ConfidenceDetails bool   `json:"confidence_details,omitempty"`
+	BoundingBoxes     bool   `json:"bounding_boxes,omitempty"`
+}
+
+// AnyDocumentGetOptions describes the query parameters to get an any-document.
+type AnyDocumentGetOptions struct {
+	ConfidenceDetails bool `json:"confidence_details"`
+	BoundingBoxes     bool `json:"bounding_boxes"`
+}
+
+// AnyDocumentSearchOptions describes the query parameters to search
+// any-documents.
+type AnyDocumentSearchOptions struct {
+	BlueprintName string `json:"blueprint_name"`
+	ExternalID    string `json:"external_id"`
+	CreatedGT     string `json:"created_date__gt"`
+	CreatedGTE    string `json:"created_date__gte"`
+	CreatedLT     string `json:"created_date__lt"`
+	CreatedLTE    string `json:"created_date__lte"`
+	Page          string `json:"page"`
+	PageSize      string `json:"page_size"`
+}
+
+// AnyDocuments describes a list of any-documents.
+type AnyDocuments struct {
+	Documents []AnyDocument `json:"documents"`
+	Meta      DocumentsMeta `json:"meta"`
+}
+
+// anyDocumentMetadata holds the fields common to every any-document,
+// regardless of its blueprint.
+type anyDocumentMetadata struct {
+	ID              int    `json:"id"`
+	BlueprintName   string `json:"blueprint_name"`
+	ExternalID      string `json:"external_id,omitempty"`
+	Created         string `json:"created_date,omitempty"`
+	Updated         string `json:"updated_date,omitempty"`
+	ImgURL          string `json:"img_url,omitempty"`
+	ImgThumbnailURL string `json:"img_thumbnail_url,omitempty"`
+	PDFURL          string `json:"pdf_url,omitempty"`
+}
+
+// anyDocumentMetadataKeys lists the JSON names of anyDocumentMetadata.
+var anyDocumentMetadataKeys = []string{
+	"id", "blueprint_name", "external_id", "created_date", "updated_date",
+	"img_url", "img_thumbnail_url", "pdf_url",
+}
+
+// AnyDocument describes a document processed with a blueprint. The fields
+// extracted by the blueprint depend on it, so they are kept in Fields and can
+// be decoded into a user-supplied struct with Decode or DecodeAnyDocument.
+type AnyDocument struct {
+	ID              int
+	BlueprintName   string
+	ExternalID      string
+	Created         string
+	Updated         string
+	ImgURL          string
+	ImgThumbnailURL string
+	PDFURL          string
+
+	// Fields holds every field other than the ones above, as extracted by the
+	// blueprint. With confidence details enabled, each value is an object
+	// that can be read with DetailedField. Numbers are json.Number values so
+	// that large ones keep their precision.
+	Fields map[string]any
+
+	// Raw holds the JSON the document was decoded from.
+	Raw json.RawMessage `json:"-"`
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (d *AnyDocument) UnmarshalJSON(data []byte) error {
+	meta := anyDocumentMetadata{}
+	if err := json.Unmarshal(data, &meta); err != nil {
+		return err
+	}
+	fields := map[string]any{}
+	if err := unmarshalNumbers(data, &fields); err != nil {
+		return err
+	}
+	for _, k := range anyDocumentMetadataKeys {
+		delete(fields, k)
+	}
+
+	*d = AnyDocument{
+		ID:              meta.ID,
+		BlueprintName:   meta.BlueprintName,
+		ExternalID:      meta.ExternalID,
+		Created:         meta.Created,
+		Updated:         meta.Updated,
+		ImgURL:          meta.ImgURL,
+		ImgThumbnailURL: meta.ImgThumbnailURL,
+		PDFURL:          meta.PDFURL,
+		Fields:          fields,
+		Raw:             append(json.RawMessage(nil), data...),
+	}
+
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler. The metadata and Fields are merged
+// back into a single object.
+func (d AnyDocument) MarshalJSON() ([]byte, error) {
+	metaJSON, err := json.Marshal(anyDocumentMetadata{
+		ID:              d.ID,
+		BlueprintName:   d.BlueprintName,
+		ExternalID:      d.ExternalID,
+		Created:         d.Created,
+		Updated:         d.Updated,
+		ImgURL:          d.ImgURL,
+		ImgThumbnailURL: d.ImgThumbnailURL,
+		PDFURL:          d.PDFURL,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	out := map[string]any{}
+	for k, v := range d.Fields {
+		out[k] = v
+	}
+	meta := map[string]any{}
+	if err := json.Unmarshal(metaJSON, &meta); err != nil {
+		return nil, err
+	}
+	for k, v := range meta {
+		out[k] = v
+	}
+
+	return json.Marshal(out)
+}
+
+// Decode decodes the document, metadata and extracted fields alike, into v,
+// which is typically a pointer to a struct with json tags matching the
+// blueprint, or to a map[string]any. It decodes the metadata and Fields, so
+// changes made to them are seen, rather than Raw. Numbers decoded into an
+// interface value are json.Number values.
+func (d *AnyDocument) Decode(v any) error {
+	data, err := json.Marshal(d)
+	if err != nil {
+		return err
+	}
+
+	return unmarshalNumbers(data, v)
+}
+
+// DecodeAnyDocument decodes an any-document into a new value of type T.
+func DecodeAnyDocument[T any](d *AnyDocument) (*T, error) {
+	out := new(T)
+	if err := d.Decode(out); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+// DetailedAnyField represents an extracted field of an any-document with
+// confidence details enabled. A number Value is a json.Number.
+type DetailedAnyField struct {
+	Value          any       `json:"value"`
+	Score          *float64  `json:"score,omitempty"`
+	OCRScore       *float64  `json:"ocr_score,omitempty"`
+	BoundingBox    []float64 `json:"bounding_box,omitempty"`
+	BoundingRegion []float64 `json:"bounding_region,omitempty"`
+	Rotation       int       `json:"rotation,omitempty"`
+}
+
+// DetailedField returns the top-level extracted field called name with its
+// confidence details. It reports false if the field is missing or is not an
+// object carrying a value, e.g. because confidence details were not enabled.
+func (d *AnyDocument) DetailedField(name string) (*DetailedAnyField, bool) {
+	raw, ok := d.Fields[name].(map[string]any)
+	if !ok {
+		return nil, false
+	}
+	if _, ok := raw["value"]; !ok {
+		return nil, false
+	}
+
+	data, err := json.Marshal(raw)
+	if err != nil {
+		return nil, false
+	}
+	out := &DetailedAnyField{}
+	if err := unmarshalNumbers(data, out); err != nil {
+		return nil, false
+	}
+
+	return out, true
+}
+
+// unmarshalNumbers is like json.Unmarshal, except that numbers decoded into an
+// interface value are json.Number values instead of float64.
+func unmarshalNumbers(data []byte, v any) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+
+	return dec.Decode(v)
+}
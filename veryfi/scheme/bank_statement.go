@@ -0,0 +1,131 @@
+package scheme
+
+import "encoding/json"
+
+// BankStatementUploadOptions describes the query parameters to process a bank statement file upload.
+type BankStatementUploadOptions struct {
+	FilePath string
+
+	BankStatementSharedOptions
+}
+
+// BankStatementUploadBase64Options describes the query parameters to process a Base64 encoded bank statement.
+type BankStatementUploadBase64Options struct {
+	FileData string `json:"file_data,omitempty"`
+
+	BankStatementSharedOptions
+}
+
+// BankStatementURLOptions describes the query parameters to process a bank statement using a URL.
+type BankStatementURLOptions struct {
+	FileURL  string   `json:"file_url,omitempty"`
+	FileURLS []string `json:"file_urls,omitempty"`
+
+	BankStatementSharedOptions
+}
+
+// BankStatementSharedOptions describes the shared query parameters among the bank statement processing API.
+type BankStatementSharedOptions struct {
+	FileName          string `json:"file_name,omitempty"`
+	ExternalID        string `json:"external_id,omitempty"`
+	Async             bool   `json:"async,omitempty"`
+	ConfidenceDetails bool   `json:"confidence_details,omitempty"`
+	BoundingBoxes     bool   `json:"bounding_boxes,omitempty"`
+}
+
+// BankStatementGetOptions describes the query parameters to get a bank statement.
+type BankStatementGetOptions struct {
+	BoundingBoxes bool `json:"bounding_boxes"`
+}
+
+// BankStatementSearchOptions describes the query parameters to search bank statements.
+type BankStatementSearchOptions struct {
+	ExternalID string `json:"external_id"`
+	CreatedGT  string `json:"created_date__gt"`
+	CreatedGTE string `json:"created_date__gte"`
+	CreatedLT  string `json:"created_date__lt"`
+	CreatedLTE string `json:"created_date__lte"`
+	Page       string `json:"page"`
+	PageSize   string `json:"page_size"`
+}
+
+// BankStatements describes a list of bank statements.
+type BankStatements struct {
+	BankStatements []BankStatement `json:"documents"`
+	Meta           DocumentsMeta   `json:"meta"`
+}
+
+// BankStatement describes the bank statement response.
+type BankStatement struct {
+	ID              int    `json:"id"`
+	ExternalID      string `json:"external_id"`
+	Created         string `json:"created_date"`
+	Updated         string `json:"updated_date"`
+	ImgFileName     string `json:"img_file_name"`
+	ImgThumbnailURL string `json:"img_thumbnail_url"`
+	ImgURL          string `json:"img_url"`
+	PDFURL          string `json:"pdf_url"`
+	OCRText         string `json:"ocr_text"`
+
+	// Account information.
+	AccountHolderAddress string   `json:"account_holder_address"`
+	AccountHolderName    string   `json:"account_holder_name"`
+	AccountNumber        string   `json:"account_number"`
+	AccountNumbers       []string `json:"account_numbers"`
+	AccountType          string   `json:"account_type"`
+	BankAddress          string   `json:"bank_address"`
+	BankName             string   `json:"bank_name"`
+	BankWebsite          string   `json:"bank_website"`
+	CurrencyCode         string   `json:"currency_code"`
+	IBAN                 string   `json:"iban"`
+	RoutingNumber        string   `json:"routing_number"`
+	RoutingNumbers       []string `json:"routing_numbers"`
+	SWIFT                string   `json:"swift"`
+
+	// Statement period.
+	StatementDate   string `json:"statement_date"`
+	PeriodStartDate string `json:"period_start_date"`
+	PeriodEndDate   string `json:"period_end_date"`
+	DueDate         string `json:"due_date"`
+
+	// Balances.
+	BeginningBalance Amount `json:"beginning_balance"`
+	EndingBalance    Amount `json:"ending_balance"`
+	MinimumDue       Amount `json:"minimum_due"`
+
+	Summaries    []BankStatementSummary `json:"summaries"`
+	Transactions []BankTransaction      `json:"transactions"`
+
+	// Extra holds the fields of the response that BankStatement does not declare.
+	Extra map[string]json.RawMessage `json:"-"`
+
+	// Raw holds the JSON the document was decoded from.
+	Raw json.RawMessage `json:"-"`
+}
+
+// BankStatementSummary describes a named total printed on a bank statement,
+// e.g. "Total deposits".
+type BankStatementSummary struct {
+	Name  string `json:"name"`
+	Total Amount `json:"total"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// BankTransaction describes a transaction of a bank statement. A transaction
+// has either a credit or a debit amount, so both are nil when absent.
+type BankTransaction struct {
+	Order         int       `json:"order"`
+	Date          string    `json:"date"`
+	Description   string    `json:"description"`
+	CreditAmount  *Amount   `json:"credit_amount"`
+	DebitAmount   *Amount   `json:"debit_amount"`
+	Balance       *Amount   `json:"balance"`
+	CardNumber    string    `json:"card_number"`
+	Category      string    `json:"category"`
+	TransactionID string    `json:"transaction_id"`
+	Text          string    `json:"text"`
+	BoundingBox   []float64 `json:"bounding_box,omitempty"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
@@ -0,0 +1,112 @@
+package scheme
+
+import "encoding/json"
+
+// BusinessCardUploadOptions describes the query parameters to process a business card file upload.
+type BusinessCardUploadOptions struct {
+	FilePath string
+
+	BusinessCardSharedOptions
+}
+
+// BusinessCardUploadBase64Options describes the query parameters to process a Base64 encoded business card.
+type BusinessCardUploadBase64Options struct {
+	FileData string `json:"file_data,omitempty"`
+
+	BusinessCardSharedOptions
+}
+
+// BusinessCardURLOptions describes the query parameters to process a business card using a URL.
+type BusinessCardURLOptions struct {
+	FileURL  string   `json:"file_url,omitempty"`
+	FileURLS []string `json:"file_urls,omitempty"`
+
+	BusinessCardSharedOptions
+}
+
+// BusinessCardSharedOptions describes the shared query parameters among the business card processing API.
+type BusinessCardSharedOptions struct {
+	FileName     string `json:"file_name,omitempty"`
+	ExternalID   string `json:"external_id,omitempty"`
+	Async        bool   `json:"async,omitempty"`
+	ParseAddress bool   `json:"parse_address,omitempty"`
+}
+
+// BusinessCardSearchOptions describes the query parameters to search business cards.
+type BusinessCardSearchOptions struct {
+	ExternalID string `json:"external_id"`
+	CreatedGT  string `json:"created_date__gt"`
+	CreatedGTE string `json:"created_date__gte"`
+	CreatedLT  string `json:"created_date__lt"`
+	CreatedLTE string `json:"created_date__lte"`
+	Page       string `json:"page"`
+	PageSize   string `json:"page_size"`
+}
+
+// BusinessCards describes a list of business cards.
+type BusinessCards struct {
+	BusinessCards []BusinessCard `json:"documents"`
+	Meta          DocumentsMeta  `json:"meta"`
+}
+
+// BusinessCard describes the business card response.
+type BusinessCard struct {
+	ID              int    `json:"id"`
+	ExternalID      string `json:"external_id"`
+	Created         string `json:"created_date"`
+	Updated         string `json:"updated_date"`
+	ImgFileName     string `json:"img_file_name"`
+	ImgThumbnailURL string `json:"img_thumbnail_url"`
+	ImgURL          string `json:"img_url"`
+	OCRText         string `json:"ocr_text"`
+
+	FullName   string `json:"full_name"`
+	Prefix     string `json:"name_prefix"`
+	FirstName  string `json:"first_name"`
+	MiddleName string `json:"middle_name"`
+	LastName   string `json:"last_name"`
+	Suffix     string `json:"name_suffix"`
+
+	JobTitles     []string         `json:"job_titles"`
+	Departments   []string         `json:"departments"`
+	CompanyNames  []string         `json:"company_names"`
+	Phones        []ContactPhone   `json:"phones"`
+	Emails        []string         `json:"emails"`
+	Addresses     []ContactAddress `json:"addresses"`
+	Websites      []string         `json:"websites"`
+	SocialHandles []SocialHandle   `json:"social_media"`
+
+	// Extra holds the fields of the response that BusinessCard does not declare.
+	Extra map[string]json.RawMessage `json:"-"`
+
+	// Raw holds the JSON the document was decoded from.
+	Raw json.RawMessage `json:"-"`
+}
+
+// ContactPhone describes a phone number of a contact.
+type ContactPhone struct {
+	Number string `json:"number"`
+
+	// Type is one of "work", "home", "mobile" or "fax", or empty if unknown.
+	Type string `json:"type"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// ContactAddress describes a postal address of a contact.
+type ContactAddress struct {
+	Address       string        `json:"address"`
+	Type          string        `json:"type"`
+	ParsedAddress ParsedAddress `json:"parsed_address"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// SocialHandle describes a social network profile of a contact.
+type SocialHandle struct {
+	Network string `json:"network"`
+	Handle  string `json:"handle"`
+	URL     string `json:"url"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
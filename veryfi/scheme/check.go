@@ -0,0 +1,134 @@
+package scheme
+
+import "encoding/json"
+
+// CheckUploadOptions describes the query parameters to process a check file
+// upload. BackFilePath is optional and holds the image of the back of the
+// check, which carries the endorsement.
+type CheckUploadOptions struct {
+	FilePath     string
+	BackFilePath string
+
+	CheckSharedOptions
+}
+
+// CheckUploadBase64Options describes the query parameters to process a Base64 encoded check.
+type CheckUploadBase64Options struct {
+	FileData     string `json:"file_data,omitempty"`
+	BackFileData string `json:"back_file_data,omitempty"`
+
+	CheckSharedOptions
+}
+
+// CheckURLOptions describes the query parameters to process a check using a URL.
+type CheckURLOptions struct {
+	FileURL     string `json:"file_url,omitempty"`
+	BackFileURL string `json:"back_file_url,omitempty"`
+
+	CheckSharedOptions
+}
+
+// CheckSharedOptions describes the shared query parameters among the check processing API.
+type CheckSharedOptions struct {
+	FileName          string `json:"file_name,omitempty"`
+	ExternalID        string `json:"external_id,omitempty"`
+	Async             bool   `json:"async,omitempty"`
+	ConfidenceDetails bool   `json:"confidence_details,omitempty"`
+	BoundingBoxes     bool   `json:"bounding_boxes,omitempty"`
+}
+
+// CheckGetOptions describes the query parameters to get a check.
+type CheckGetOptions struct {
+	BoundingBoxes bool `json:"bounding_boxes"`
+}
+
+// CheckSearchOptions describes the query parameters to search checks.
+type CheckSearchOptions struct {
+	ExternalID string `json:"external_id"`
+	CreatedGT  string `json:"created_date__gt"`
+	CreatedGTE string `json:"created_date__gte"`
+	CreatedLT  string `json:"created_date__lt"`
+	CreatedLTE string `json:"created_date__lte"`
+	Page       string `json:"page"`
+	PageSize   string `json:"page_size"`
+}
+
+// Checks describes a list of checks.
+type Checks struct {
+	Checks []Check       `json:"documents"`
+	Meta   DocumentsMeta `json:"meta"`
+}
+
+// Check describes the check response.
+type Check struct {
+	ID                int    `json:"id"`
+	ExternalID        string `json:"external_id"`
+	Created           string `json:"created_date"`
+	Updated           string `json:"updated_date"`
+	ImgFileName       string `json:"img_file_name"`
+	ImgThumbnailURL   string `json:"img_thumbnail_url"`
+	ImgURL            string `json:"img_url"`
+	BackImgURL        string `json:"back_img_url"`
+	PDFURL            string `json:"pdf_url"`
+	OCRText           string `json:"ocr_text"`
+	BankAddress       string `json:"bank_address"`
+	BankName          string `json:"bank_name"`
+	CheckNumber       string `json:"check_number"`
+	CheckType         string `json:"check_type"`
+	CurrencyCode      string `json:"currency_code"`
+	Date              string `json:"date"`
+	FractionalRouting string `json:"fractional_routing_number"`
+	IsSigned          bool   `json:"is_signed"`
+	Memo              string `json:"memo"`
+	PayerAddress      string `json:"payer_address"`
+	PayerName         string `json:"payer_name"`
+	ReceiverAddress   string `json:"receiver_address"`
+	ReceiverName      string `json:"receiver_name"`
+	Amount            Amount `json:"amount"`
+	AmountText        string `json:"amount_text"`
+
+	MICR        CheckMICR        `json:"micr"`
+	Endorsement CheckEndorsement `json:"endorsement"`
+	Stubs       []CheckStub      `json:"stubs"`
+
+	// Extra holds the fields of the response that Check does not declare.
+	Extra map[string]json.RawMessage `json:"-"`
+
+	// Raw holds the JSON the document was decoded from.
+	Raw json.RawMessage `json:"-"`
+}
+
+// CheckMICR describes the magnetic ink character recognition line printed at
+// the bottom of a check.
+type CheckMICR struct {
+	Raw           string  `json:"raw"`
+	RoutingNumber string  `json:"routing_number"`
+	AccountNumber string  `json:"account_number"`
+	SerialNumber  string  `json:"serial_number"`
+	Score         float64 `json:"score"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// CheckEndorsement describes the endorsement on the back of a check.
+type CheckEndorsement struct {
+	IsEndorsed            bool   `json:"is_endorsed"`
+	IsSigned              bool   `json:"is_signed"`
+	MobileOrRemoteDeposit bool   `json:"mobile_or_remote_deposit"`
+	Text                  string `json:"text"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// CheckStub describes a remittance stub attached to a check.
+type CheckStub struct {
+	Date          string  `json:"date"`
+	Description   string  `json:"description"`
+	InvoiceNumber string  `json:"invoice_number"`
+	AccountNumber string  `json:"account_number"`
+	Amount        *Amount `json:"amount"`
+	Discount      *Amount `json:"discount"`
+	Text          string  `json:"text"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
@@ -0,0 +1,68 @@
+package scheme
+
+import "encoding/json"
+
+// DocumentType describes the type of a document as returned by the classify API.
+type DocumentType string
+
+const (
+	DocumentTypeReceipt       DocumentType = "receipt"
+	DocumentTypeInvoice       DocumentType = "invoice"
+	DocumentTypeBankStatement DocumentType = "bank_statement"
+	DocumentTypeCheck         DocumentType = "check"
+	DocumentTypeW2            DocumentType = "w2"
+	DocumentTypeW8            DocumentType = "w8"
+	DocumentTypeW9            DocumentType = "w9"
+	DocumentTypeBusinessCard  DocumentType = "business_card"
+	DocumentTypeOther         DocumentType = "other"
+)
+
+// ClassifyUploadOptions describes the query parameters to classify a file upload.
+type ClassifyUploadOptions struct {
+	FilePath string
+
+	ClassifySharedOptions
+}
+
+// ClassifyUploadBase64Options describes the query parameters to classify a Base64 encoded file.
+type ClassifyUploadBase64Options struct {
+	FileData string `json:"file_data,omitempty"`
+
+	ClassifySharedOptions
+}
+
+// ClassifyURLOptions describes the query parameters to classify a file using a URL.
+type ClassifyURLOptions struct {
+	FileURL string `json:"file_url,omitempty"`
+
+	ClassifySharedOptions
+}
+
+// ClassifySharedOptions describes the shared query parameters among the classify API.
+type ClassifySharedOptions struct {
+	FileName string `json:"file_name,omitempty"`
+
+	// DocumentTypes restricts the classification to the given types, which
+	// may include custom ones.
+	DocumentTypes []DocumentType `json:"document_types,omitempty"`
+}
+
+// Classification describes the classify response.
+type Classification struct {
+	ID           int                `json:"id"`
+	DocumentType ClassifiedDocument `json:"document_type"`
+
+	// Extra holds the fields of the response that Classification does not declare.
+	Extra map[string]json.RawMessage `json:"-"`
+
+	// Raw holds the JSON the document was decoded from.
+	Raw json.RawMessage `json:"-"`
+}
+
+// ClassifiedDocument describes the type a document was classified as.
+type ClassifiedDocument struct {
+	Value DocumentType `json:"value"`
+	Score float64      `json:"score"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
@@ -0,0 +1,269 @@
+package scheme
+
+import (
+	"reflect"
+	"strconv"
+
+	"github.com/pkg/errors"
+)
+
+// FieldConfidence is the metadata of a detailed field besides its value.
+type FieldConfidence struct {
+	Enriched       *bool     `json:"enriched,omitempty"`
+	Score          *float64  `json:"score,omitempty"`
+	OCRScore       *float64  `json:"ocr_score,omitempty"`
+	BoundingBox    []float64 `json:"bounding_box,omitempty"`
+	BoundingRegion []float64 `json:"bounding_region,omitempty"`
+	Rotation       int       `json:"rotation,omitempty"`
+}
+
+// DocumentConfidence holds the metadata that Document drops from a
+// DetailedDocument, so that it can be stored alongside it.
+type DocumentConfidence struct {
+	DocumentID int `json:"document_id"`
+
+	// Fields maps the JSON paths of the detailed fields present in the
+	// response, as reported by WalkDetailed, to their metadata.
+	Fields map[string]FieldConfidence `json:"fields"`
+}
+
+// ToDocument returns the plain form of d, and the confidence metadata that it
+// drops. Line items and tax lines are taken from LineItems and TaxLines,
+// completed with the values of LineItemsWithScores and TaxLinesWithScores.
+// It fails on values the plain form can not hold, such as a NaN amount.
+func (d *DetailedDocument) ToDocument() (Document, *DocumentConfidence, error) {
+	doc := Document{}
+	conf := &DocumentConfidence{DocumentID: d.ID, Fields: map[string]FieldConfidence{}}
+	if err := toPlain(reflect.ValueOf(&doc).Elem(), reflect.ValueOf(d).Elem(), "", conf.Fields); err != nil {
+		return Document{}, nil, err
+	}
+
+	return doc, conf, nil
+}
+
+// NewDetailedDocument returns the detailed form of doc, with the confidence
+// metadata of conf, which may be nil. Fields that are zero in doc and have no
+// metadata are left out, as the API does for values it did not find, and so
+// are other zero values the detailed form keeps as pointers.
+func NewDetailedDocument(doc *Document, conf *DocumentConfidence) DetailedDocument {
+	var fields map[string]FieldConfidence
+	if conf != nil {
+		fields = conf.Fields
+	}
+
+	detailed := DetailedDocument{}
+	toDetailed(reflect.ValueOf(&detailed).Elem(), reflect.ValueOf(doc).Elem(), "", fields)
+
+	return detailed
+}
+
+// detailedField is implemented by every instance of Detailed.
+type detailedField interface {
+	scoredField
+	confidence() FieldConfidence
+	setConfidence(FieldConfidence)
+}
+
+var (
+	detailedFieldType = reflect.TypeOf((*detailedField)(nil)).Elem()
+	amountType        = reflect.TypeOf(Amount{})
+)
+
+// plainNames maps the JSON names of detailed fields to those of the plain
+// fields they correspond to, where they differ.
+var plainNames = map[string]string{
+	"line_items_with_scores": "line_items",
+	"tax_lines_with_scores":  "tax_lines",
+}
+
+// toPlain sets dst from the detailed value src at path, and records the
+// metadata of detailed fields in conf.
+func toPlain(dst, src reflect.Value, path string, conf map[string]FieldConfidence) error {
+	if src.Type().Implements(detailedFieldType) {
+		if src.IsNil() {
+			return nil
+		}
+		conf[path] = src.Interface().(detailedField).confidence()
+		value := src.Elem().FieldByName("Value")
+		if value.IsNil() {
+			return nil
+		}
+		ok, err := assign(dst, value.Elem())
+		if err != nil {
+			return errors.Wrapf(err, "fail to convert %s", path)
+		}
+		if !ok {
+			return errors.Errorf("fail to convert %s: %s does not fit a %s", path, value.Elem().Type(), dst.Type())
+		}
+		return nil
+	}
+	if src.Kind() == reflect.Pointer {
+		if src.IsNil() {
+			return nil
+		}
+		return toPlain(dst, src.Elem(), path, conf)
+	}
+	ok, err := assign(dst, src)
+	if err != nil {
+		return errors.Wrapf(err, "fail to convert %s", path)
+	}
+	if ok {
+		return nil
+	}
+
+	switch {
+	case src.Kind() == reflect.Struct && dst.Kind() == reflect.Struct:
+		dstFields := fieldsByName(dst)
+		for i := 0; i < src.NumField(); i++ {
+			name := jsonName(src.Type().Field(i))
+			if name == "" {
+				continue
+			}
+			target, ok := dstFields[plainName(name)]
+			if !ok {
+				continue
+			}
+			if err := toPlain(target, src.Field(i), joinPath(path, name), conf); err != nil {
+				return err
+			}
+		}
+
+	case src.Kind() == reflect.Slice && dst.Kind() == reflect.Slice:
+		// Plain elements may have been set from a plain field already, in
+		// which case the detailed values are laid over them.
+		if dst.Len() == 0 && src.Len() > 0 {
+			dst.Set(reflect.MakeSlice(dst.Type(), src.Len(), src.Len()))
+		}
+		for i := 0; i < min(dst.Len(), src.Len()); i++ {
+			if err := toPlain(dst.Index(i), src.Index(i), path+"["+strconv.Itoa(i)+"]", conf); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// toDetailed sets the detailed value dst at path from src, with the metadata
+// recorded in conf. Amounts only ever convert to floats this way, so unlike
+// toPlain it can not fail.
+func toDetailed(dst, src reflect.Value, path string, conf map[string]FieldConfidence) {
+	if src.Kind() == reflect.Pointer {
+		if src.IsNil() {
+			src = reflect.Zero(src.Type().Elem())
+		} else {
+			src = src.Elem()
+		}
+	}
+	if dst.Type().Implements(detailedFieldType) {
+		c, ok := conf[path]
+		if src.IsZero() && !ok {
+			return
+		}
+
+		// A recorded boolean field has a value even when it is false, which
+		// the plain form can not tell apart from an absent one otherwise.
+		field := reflect.New(dst.Type().Elem())
+		if !src.IsZero() || ok && src.Kind() == reflect.Bool {
+			value := field.Elem().FieldByName("Value")
+			value.Set(reflect.New(value.Type().Elem()))
+			_, _ = assign(value.Elem(), src)
+		}
+		field.Interface().(detailedField).setConfidence(c)
+		dst.Set(field)
+		return
+	}
+	if ok, _ := assign(dst, src); ok {
+		return
+	}
+
+	switch {
+	case dst.Kind() == reflect.Pointer:
+		elem := reflect.New(dst.Type().Elem())
+		if src.Type().AssignableTo(elem.Elem().Type()) || src.Type() == amountType {
+			if src.IsZero() {
+				return
+			}
+			_, _ = assign(elem.Elem(), src)
+		} else {
+			toDetailed(elem.Elem(), src, path, conf)
+		}
+		dst.Set(elem)
+
+	case src.Kind() == reflect.Struct && dst.Kind() == reflect.Struct:
+		srcFields := fieldsByName(src)
+		for i := 0; i < dst.NumField(); i++ {
+			name := jsonName(dst.Type().Field(i))
+			if name == "" {
+				continue
+			}
+			if source, ok := srcFields[plainName(name)]; ok {
+				toDetailed(dst.Field(i), source, joinPath(path, name), conf)
+			}
+		}
+
+	case src.Kind() == reflect.Slice && dst.Kind() == reflect.Slice:
+		if src.Len() == 0 {
+			return
+		}
+		dst.Set(reflect.MakeSlice(dst.Type(), src.Len(), src.Len()))
+		for i := 0; i < src.Len(); i++ {
+			toDetailed(dst.Index(i), src.Index(i), path+"["+strconv.Itoa(i)+"]", conf)
+		}
+	}
+}
+
+// assign sets dst to src if their types are compatible, converting between
+// Amount and float64, and reports whether it did. It fails on a float that no
+// Amount represents, such as NaN.
+func assign(dst, src reflect.Value) (bool, error) {
+	switch {
+	case src.Type() == amountType && dst.Kind() == reflect.Float64:
+		dst.SetFloat(src.Interface().(Amount).Float64())
+	case dst.Type() == amountType && src.Kind() == reflect.Float64:
+		a, err := AmountFromFloat(src.Float())
+		if err != nil {
+			return false, err
+		}
+		dst.Set(reflect.ValueOf(a))
+	case src.Type().AssignableTo(dst.Type()):
+		dst.Set(src)
+	case src.Kind() == dst.Kind() && src.Kind() != reflect.Struct && src.Type().ConvertibleTo(dst.Type()):
+		dst.Set(src.Convert(dst.Type()))
+	default:
+		return false, nil
+	}
+
+	return true, nil
+}
+
+// fieldsByName returns the encoded fields of the struct v by JSON name.
+func fieldsByName(v reflect.Value) map[string]reflect.Value {
+	fields := map[string]reflect.Value{}
+	for i := 0; i < v.NumField(); i++ {
+		if name := jsonName(v.Type().Field(i)); name != "" {
+			fields[name] = v.Field(i)
+		}
+	}
+
+	return fields
+}
+
+// plainName returns the JSON name of the plain field corresponding to the
+// detailed field name.
+func plainName(name string) string {
+	if plain, ok := plainNames[name]; ok {
+		return plain
+	}
+
+	return name
+}
+
+// joinPath returns the JSON path of the field name of the object at path.
+func joinPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+
+	return path + "." + name
+}
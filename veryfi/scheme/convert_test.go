@@ -0,0 +1,82 @@
+package scheme
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitDetailedDocument_ToDocument(t *testing.T) {
+	detailed := loadDetailedReceipt(t)
+	doc, conf, err := detailed.ToDocument()
+	assert.NoError(t, err)
+
+	assert.Equal(t, 36966934, doc.ID)
+	assert.Equal(t, MustParseAmount("29.53"), doc.Total)
+	assert.Equal(t, MustParseAmount("1.2"), doc.Discount)
+	assert.Equal(t, "2022-05-24 13:10:00", doc.Date)
+	assert.Equal(t, "USD", doc.CurrencyCode)
+	assert.Equal(t, "Walgreens", doc.Vendor.Name)
+	assert.Equal(t, 37.564947, doc.Vendor.Lat)
+	assert.Equal(t, "visa", doc.Payment.Type)
+	assert.Equal(t, "1850", doc.Payment.CardNumber)
+	assert.Equal(t, "2025-03-25 20:57:29", doc.Updated)
+	assert.True(t, doc.IsDuplicate)
+
+	// Line items keep the fields only the plain form has.
+	assert.Len(t, doc.LineItems, 2)
+	assert.Equal(t, MustParseAmount("8.79"), doc.LineItems[0].Total)
+	assert.Equal(t, "RED BULL ENERGY DRINK CNS 8.4OZ 6PK", doc.LineItems[0].NormalizedDescription)
+	assert.Equal(t, 9.625, doc.TaxLines[0].Rate)
+
+	assert.Equal(t, 36966934, conf.DocumentID)
+	assert.Equal(t, 0.95, *conf.Fields["line_items_with_scores[0].total"].Score)
+	assert.Len(t, conf.Fields["total"].BoundingBox, 5)
+	assert.Equal(t, 0.4, *conf.Fields["vendor.web"].Score)
+	assert.Contains(t, conf.Fields, "bill_to.name")
+}
+
+func TestUnitNewDetailedDocument(t *testing.T) {
+	detailed := loadDetailedReceipt(t)
+	doc, conf, err := detailed.ToDocument()
+	assert.NoError(t, err)
+
+	// The confidence side-car survives being stored as JSON.
+	b, err := json.Marshal(conf)
+	assert.NoError(t, err)
+	stored := &DocumentConfidence{}
+	assert.NoError(t, json.Unmarshal(b, stored))
+
+	back := NewDetailedDocument(&doc, stored)
+	assert.Equal(t, DetailedFields(detailed), DetailedFields(&back))
+	assert.Equal(t, detailed.LineItems, back.LineItems)
+	assert.Equal(t, detailed.TaxLines, back.TaxLines)
+	assert.Equal(t, detailed.Barcodes, back.Barcodes)
+	assert.Equal(t, detailed.Updated, back.Updated)
+	assert.Equal(t, detailed.Payment, back.Payment)
+
+	// Without the side-car, fields with values have no scores and the others
+	// are left out.
+	plain := NewDetailedDocument(&doc, nil)
+	assert.Equal(t, 29.53, *plain.Total.Value)
+	assert.Nil(t, plain.Total.Score)
+	assert.Nil(t, plain.Tip)
+	assert.Nil(t, plain.ExternalID)
+	assert.Equal(t, "CA REDMP VAL", *plain.LineItemsWithScores[1].Description.Value)
+	assert.Nil(t, plain.LineItemsWithScores[1].Price)
+
+	again, _, err := plain.ToDocument()
+	assert.NoError(t, err)
+	assert.Equal(t, doc, again)
+}
+
+func TestUnitDetailedDocument_ToDocumentInvalid(t *testing.T) {
+	detailed := loadDetailedReceipt(t)
+	detailed.LineItemsWithScores[1].Total = NewDetailed(math.NaN(), 0.9)
+
+	_, conf, err := detailed.ToDocument()
+	assert.EqualError(t, err, "fail to convert line_items_with_scores[1].total: invalid amount NaN")
+	assert.Nil(t, conf)
+}
@@ -0,0 +1,123 @@
+package scheme
+
+import (
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// DateTimeLayout is the layout the API uses for dates with a time, such
+	// as Document.Date and Document.Created.
+	DateTimeLayout = "2006-01-02 15:04:05"
+
+	// DateLayout is the layout the API uses for dates without a time, such as
+	// Document.DueDate.
+	DateLayout = "2006-01-02"
+)
+
+// dateLayouts lists the layouts ParseDate accepts, most common first. Layouts
+// without a zone are read in the location given to ParseDate.
+var dateLayouts = []struct {
+	layout string
+	zoned  bool
+}{
+	{DateTimeLayout, false},
+	{DateLayout, false},
+	{"2006-01-02 15:04:05.999999999", false},
+	{"2006-01-02 15:04", false},
+	{"2006-01-02T15:04:05.999999999", false},
+	{"2006-01-02T15:04", false},
+	{time.RFC3339Nano, true},
+	{"2006-01-02 15:04:05.999999999Z07:00", true},
+	{"2006-01-02 15:04:05.999999999-0700", true},
+}
+
+// ParseDate parses a date as the API emits it, e.g. "2021-06-22 16:11:10",
+// "2021-06-22" or "2021-06-22T16:11:10Z". Dates without a zone are wall
+// times, read in loc, or UTC if loc is nil. Dates with a zone keep it. An
+// empty date returns the zero Time and no error.
+func ParseDate(s string, loc *time.Location) (time.Time, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return time.Time{}, nil
+	}
+	if loc == nil {
+		loc = time.UTC
+	}
+
+	for _, l := range dateLayouts {
+		var t time.Time
+		var err error
+		if l.zoned {
+			t, err = time.Parse(l.layout, s)
+		} else {
+			t, err = time.ParseInLocation(l.layout, s, loc)
+		}
+		if err == nil {
+			return t, nil
+		}
+	}
+
+	return time.Time{}, errors.Errorf("invalid date %q", s)
+}
+
+// DateTime returns the document date in loc. Document dates are the wall time
+// printed on the document, so loc is usually the vendor's time zone.
+func (d *Document) DateTime(loc *time.Location) (time.Time, error) {
+	return ParseDate(d.Date, loc)
+}
+
+// DueDateTime returns the due date in loc.
+func (d *Document) DueDateTime(loc *time.Location) (time.Time, error) {
+	return ParseDate(d.DueDate, loc)
+}
+
+// OrderDateTime returns the order date in loc.
+func (d *Document) OrderDateTime(loc *time.Location) (time.Time, error) {
+	return ParseDate(d.OrderDate, loc)
+}
+
+// ShipDateTime returns the ship date in loc.
+func (d *Document) ShipDateTime(loc *time.Location) (time.Time, error) {
+	return ParseDate(d.ShipDate, loc)
+}
+
+// DeliveryDateTime returns the delivery date in loc.
+func (d *Document) DeliveryDateTime(loc *time.Location) (time.Time, error) {
+	return ParseDate(d.DeliveryDate, loc)
+}
+
+// ServiceStartDateTime returns the service start date in loc.
+func (d *Document) ServiceStartDateTime(loc *time.Location) (time.Time, error) {
+	return ParseDate(d.ServiceStartDate, loc)
+}
+
+// ServiceEndDateTime returns the service end date in loc.
+func (d *Document) ServiceEndDateTime(loc *time.Location) (time.Time, error) {
+	return ParseDate(d.ServiceEndDate, loc)
+}
+
+// CreatedTime returns when the document was created. The API records it in
+// UTC.
+func (d *Document) CreatedTime() (time.Time, error) {
+	return ParseDate(d.Created, time.UTC)
+}
+
+// UpdatedTime returns when the document was last updated. The API records it
+// in UTC.
+func (d *Document) UpdatedTime() (time.Time, error) {
+	return ParseDate(d.Updated, time.UTC)
+}
+
+// SetDate sets the document date to the wall time of t in its own location.
+// Convert t with t.In first to record it in another time zone.
+func (o *DocumentUpdateOptions) SetDate(t time.Time) {
+	o.Date = t.Format(DateTimeLayout)
+}
+
+// SetDueDate sets the due date to the calendar day of t in its own location.
+func (o *DocumentUpdateOptions) SetDueDate(t time.Time) {
+	o.DueDate = t.Format(DateLayout)
+}
@@ -0,0 +1,85 @@
+package scheme
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitParseDate(t *testing.T) {
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip("time zone database not available")
+	}
+
+	for in, want := range map[string]time.Time{
+		"2021-06-22 16:11:10":        time.Date(2021, 6, 22, 16, 11, 10, 0, ny),
+		"2021-06-22":                 time.Date(2021, 6, 22, 0, 0, 0, 0, ny),
+		"2021-06-22 16:11":           time.Date(2021, 6, 22, 16, 11, 0, 0, ny),
+		"2021-06-22T16:11:10":        time.Date(2021, 6, 22, 16, 11, 10, 0, ny),
+		"2021-06-22 16:11:10.250000": time.Date(2021, 6, 22, 16, 11, 10, 250000000, ny),
+		" 2021-06-22 16:11:10 ":      time.Date(2021, 6, 22, 16, 11, 10, 0, ny),
+	} {
+		got, err := ParseDate(in, ny)
+		assert.NoError(t, err, in)
+		assert.True(t, want.Equal(got), "%q: %v != %v", in, want, got)
+		assert.Equal(t, ny, got.Location(), in)
+	}
+
+	// Zoned dates keep their zone whatever loc is.
+	got, err := ParseDate("2021-06-22T16:11:10+02:00", ny)
+	assert.NoError(t, err)
+	assert.True(t, time.Date(2021, 6, 22, 14, 11, 10, 0, time.UTC).Equal(got))
+
+	// A nil location means UTC.
+	got, err = ParseDate("2021-06-22 16:11:10", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2021, 6, 22, 16, 11, 10, 0, time.UTC), got)
+
+	got, err = ParseDate("", ny)
+	assert.NoError(t, err)
+	assert.True(t, got.IsZero())
+
+	_, err = ParseDate("22/06/2021", ny)
+	assert.EqualError(t, err, `invalid date "22/06/2021"`)
+}
+
+func TestUnitDocument_DateAccessors(t *testing.T) {
+	doc := &Document{
+		Date:    "2021-06-22 16:11:10",
+		DueDate: "2021-07-22",
+		Created: "2021-06-22 20:11:10",
+	}
+
+	loc := time.FixedZone("EDT", -4*60*60)
+	date, err := doc.DateTime(loc)
+	assert.NoError(t, err)
+	created, err := doc.CreatedTime()
+	assert.NoError(t, err)
+	assert.True(t, date.Equal(created))
+
+	due, err := doc.DueDateTime(loc)
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2021, 7, 22, 0, 0, 0, 0, loc), due)
+
+	ship, err := doc.ShipDateTime(loc)
+	assert.NoError(t, err)
+	assert.True(t, ship.IsZero())
+}
+
+func TestUnitDocumentUpdateOptions_SetDate(t *testing.T) {
+	loc := time.FixedZone("CET", 60*60)
+	at := time.Date(2024, 5, 17, 23, 30, 5, 999, loc)
+
+	var opts DocumentUpdateOptions
+	opts.SetDate(at)
+	opts.SetDueDate(at)
+	assert.Equal(t, "2024-05-17 23:30:05", opts.Date)
+	assert.Equal(t, "2024-05-17", opts.DueDate)
+
+	opts.SetDueDate(at.In(time.UTC))
+	assert.Equal(t, "2024-05-17", opts.DueDate)
+	opts.SetDate(at.In(time.FixedZone("JST", 9*60*60)))
+	assert.Equal(t, "2024-05-18 07:30:05", opts.Date)
+}
@@ -0,0 +1,175 @@
+package scheme
+
+import (
+	"bytes"
+	"encoding/json"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Detailed is a field of a detailed response: an extracted value with its
+// confidence scores and location on the page. T is the type of the value,
+// e.g. string, float64 or bool.
+type Detailed[T any] struct {
+	Enriched       *bool     `json:"enriched"`
+	Value          *T        `json:"value,omitempty"`
+	Score          *float64  `json:"score,omitempty"`
+	OCRScore       *float64  `json:"ocr_score,omitempty"`
+	BoundingBox    []float64 `json:"bounding_box,omitempty"`
+	BoundingRegion []float64 `json:"bounding_region,omitempty"`
+	Rotation       int       `json:"rotation,omitempty"`
+}
+
+// detailedObject is the JSON form of Detailed, without its methods.
+type detailedObject[T any] Detailed[T]
+
+// UnmarshalJSON implements json.Unmarshaler. Besides the detailed object, it
+// accepts a bare value, which the API sends for some fields, e.g. "debit".
+func (f *Detailed[T]) UnmarshalJSON(data []byte) error {
+	if data = bytes.TrimSpace(data); len(data) > 0 && data[0] == '{' {
+		return json.Unmarshal(data, (*detailedObject[T])(f))
+	}
+
+	*f = Detailed[T]{}
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+	var v T
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	f.Value = &v
+
+	return nil
+}
+
+// DetailedField is a detailed text field.
+type DetailedField = Detailed[string]
+
+// DetailedFloatField is a detailed numeric field.
+type DetailedFloatField = Detailed[float64]
+
+// DetailedDateField is a detailed date field. Its value is a date as the API
+// emits it, see Time.
+type DetailedDateField = Detailed[string]
+
+// DetailedBoolField is a detailed boolean field.
+type DetailedBoolField = Detailed[bool]
+
+// NewDetailed returns a field with value v and confidence score.
+func NewDetailed[T any](v T, score float64) *Detailed[T] {
+	return &Detailed[T]{Value: &v, Score: &score}
+}
+
+// Get returns the value of the field and whether it has one. A nil field has
+// no value.
+func (f *Detailed[T]) Get() (T, bool) {
+	if f == nil || f.Value == nil {
+		var zero T
+		return zero, false
+	}
+
+	return *f.Value, true
+}
+
+// ValueOr returns the value of the field, or def if it has none.
+func (f *Detailed[T]) ValueOr(def T) T {
+	if v, ok := f.Get(); ok {
+		return v
+	}
+
+	return def
+}
+
+// HasValue reports whether the field has a value.
+func (f *Detailed[T]) HasValue() bool {
+	return f != nil && f.Value != nil
+}
+
+// Confidence returns the confidence score of the value, or 0 if there is
+// none.
+func (f *Detailed[T]) Confidence() float64 {
+	if f == nil || f.Score == nil {
+		return 0
+	}
+
+	return *f.Score
+}
+
+// OCRConfidence returns the confidence score of the OCR of the value, or 0 if
+// there is none.
+func (f *Detailed[T]) OCRConfidence() float64 {
+	if f == nil || f.OCRScore == nil {
+		return 0
+	}
+
+	return *f.OCRScore
+}
+
+// IsEnriched reports whether the value was inferred rather than read from the
+// document.
+func (f *Detailed[T]) IsEnriched() bool {
+	return f != nil && f.Enriched != nil && *f.Enriched
+}
+
+// HasGeometry reports whether the field was located on the page.
+func (f *Detailed[T]) HasGeometry() bool {
+	return f != nil && (len(f.BoundingBox) > 0 || len(f.BoundingRegion) > 0)
+}
+
+// Time returns the value of a date field in loc, see ParseDate. A nil field
+// or value returns the zero Time and no error. It fails on fields whose
+// values are not strings.
+func (f *Detailed[T]) Time(loc *time.Location) (time.Time, error) {
+	v, ok := f.Get()
+	if !ok {
+		return time.Time{}, nil
+	}
+
+	s, ok := any(v).(string)
+	if !ok {
+		return time.Time{}, errors.Errorf("can not read a date from a %T value", v)
+	}
+
+	return ParseDate(s, loc)
+}
+
+// scored implements scoredField.
+func (f *Detailed[T]) scored() ScoredField {
+	var value any
+	if v, ok := f.Get(); ok {
+		value = v
+	}
+
+	return ScoredField{
+		Value:          value,
+		Score:          f.Score,
+		OCRScore:       f.OCRScore,
+		BoundingBox:    f.BoundingBox,
+		BoundingRegion: f.BoundingRegion,
+		Enriched:       f.IsEnriched(),
+	}
+}
+
+// confidence returns the metadata of the field besides its value.
+func (f *Detailed[T]) confidence() FieldConfidence {
+	return FieldConfidence{
+		Enriched:       f.Enriched,
+		Score:          f.Score,
+		OCRScore:       f.OCRScore,
+		BoundingBox:    f.BoundingBox,
+		BoundingRegion: f.BoundingRegion,
+		Rotation:       f.Rotation,
+	}
+}
+
+// setConfidence sets the metadata of the field besides its value.
+func (f *Detailed[T]) setConfidence(c FieldConfidence) {
+	f.Enriched = c.Enriched
+	f.Score = c.Score
+	f.OCRScore = c.OCRScore
+	f.BoundingBox = c.BoundingBox
+	f.BoundingRegion = c.BoundingRegion
+	f.Rotation = c.Rotation
+}
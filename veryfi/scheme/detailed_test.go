@@ -0,0 +1,67 @@
+package scheme
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// confident returns the values of fields scored at least min, to check that
+// code can be written once for every value type.
+func confident[T any](min float64, fields ...*Detailed[T]) []T {
+	var out []T
+	for _, f := range fields {
+		if v, ok := f.Get(); ok && f.Confidence() >= min {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+func TestUnitDetailed(t *testing.T) {
+	var f *DetailedFloatField
+	_, ok := f.Get()
+	assert.False(t, ok)
+	assert.Equal(t, 1.5, f.ValueOr(1.5))
+	assert.Zero(t, f.Confidence())
+	assert.False(t, f.IsEnriched())
+	assert.False(t, f.HasGeometry())
+
+	enriched := true
+	f = &DetailedFloatField{}
+	err := json.Unmarshal([]byte(`{"enriched": true, "value": 29.53, "score": 0.97, "ocr_score": 0.99,
+		"bounding_box": [1, 0.1, 0.2, 0.3, 0.4], "rotation": 90}`), f)
+	assert.NoError(t, err)
+	assert.Equal(t, 29.53, f.ValueOr(0))
+	assert.True(t, f.HasValue())
+	assert.Equal(t, 0.97, f.Confidence())
+	assert.Equal(t, 0.99, f.OCRConfidence())
+	assert.True(t, f.IsEnriched())
+	assert.True(t, f.HasGeometry())
+	assert.Equal(t, &enriched, f.Enriched)
+	assert.Equal(t, 90, f.Rotation)
+
+	// The former field types are names for instances of Detailed.
+	name := "Walgreens"
+	var text *Detailed[string] = &DetailedField{Value: &name}
+	assert.Equal(t, "Walgreens", text.ValueOr(""))
+	assert.Equal(t, []string{"Walgreens"}, confident(0.9, NewDetailed("Walgreens", 0.98), NewDetailed("CA", 0.5), nil))
+	assert.Equal(t, []bool{true}, confident(0.9, NewDetailed(true, 1), &DetailedBoolField{}))
+}
+
+func TestUnitDetailed_Time(t *testing.T) {
+	date := NewDetailed("2024-05-01", 1)
+	got, err := date.Time(time.UTC)
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), got)
+
+	var missing *DetailedDateField
+	got, err = missing.Time(time.UTC)
+	assert.NoError(t, err)
+	assert.True(t, got.IsZero())
+
+	_, err = NewDetailed(1.5, 1).Time(time.UTC)
+	assert.EqualError(t, err, "can not read a date from a float64 value")
+}
@@ -1,5 +1,7 @@
 package scheme
 
+import "encoding/json"
+
 // DocumentUploadOptions describes the query parameters to process a multipart/form-data file upload.
 type DocumentUploadOptions struct {
 	FilePath string
@@ -46,10 +48,10 @@ type DocumentUpdateOptions struct {
 	Date          string              `json:"date,omitempty"`
 	DueDate       string              `json:"due_date,omitempty"`
 	InvoiceNumber string              `json:"invoice_number,omitempty"`
-	Subtotal      float64             `json:"subtotal,omitempty"`
-	Tax           float64             `json:"tax,omitempty"`
-	Tip           float64             `json:"tip,omitempty"`
-	Total         float64             `json:"total,omitempty"`
+	Subtotal      *Amount             `json:"subtotal,omitempty"`
+	Tax           *Amount             `json:"tax,omitempty"`
+	Tip           *Amount             `json:"tip,omitempty"`
+	Total         *Amount             `json:"total,omitempty"`
 	Vendor        VendorUpdateOptions `json:"vendor,omitempty"`
 	ExternalID    string              `json:"external_id,omitempty"`
 	Status        DocumentStatus      `json:"status,omitempty"` // Possible values: [processed, reviewed, archived]
@@ -129,9 +131,9 @@ type LineItemOptions struct {
 	SKU           *string  `json:"sku"`
 	Description   *string  `json:"description"`
 	Category      *string  `json:"category"`
-	Total         *float64 `json:"total"`
-	Tax           *float64 `json:"tax"`
-	Price         *float64 `json:"price"`
+	Total         *Amount  `json:"total"`
+	Tax           *Amount  `json:"tax"`
+	Price         *Amount  `json:"price"`
 	UnitOfMeasure *string  `json:"unit_of_measure"`
 	Quantity      *float64 `json:"quantity"`
 }
@@ -154,22 +156,28 @@ type DocumentsMeta struct {
 	PageNumber       int `json:"page_number"`
 	TotalPages       int `json:"total_pages"`
 	TotalResults     int `json:"total_results"`
+
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 type Barcode struct {
 	Data           string    `json:"data"`
 	Type           string    `json:"type"`
 	BoundingRegion []float64 `json:"bounding_region"`
+
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // Document describes the response.
 type Document struct {
+	ABNNumber               string         `json:"abn_number"`
 	AccountingEntryType     string         `json:"accounting_entry_type"`
 	AccountNumber           string         `json:"account_number"`
-	Balance                 float64        `json:"balance"`
+	Balance                 Amount         `json:"balance"`
 	Barcodes                []Barcode      `json:"barcodes"`
 	BillTo                  ToField        `json:"bill_to"`
-	Cashback                float64        `json:"cashback"`
+	CardNumber              string         `json:"card_number"`
+	Cashback                Amount         `json:"cashback"`
 	Category                string         `json:"category"`
 	Created                 string         `json:"created_date"`
 	CountryCode             string         `json:"country_code"`
@@ -178,21 +186,21 @@ type Document struct {
 	DefaultCategory         string         `json:"default_category"`
 	DeliveryDate            string         `json:"delivery_date"`
 	DeliveryNoteNumber      string         `json:"delivery_note_number"`
-	Discount                float64        `json:"discount"`
+	Discount                Amount         `json:"discount"`
 	DocumentReferenceNumber string         `json:"document_reference_number"`
 	DocumentTitle           string         `json:"document_title"`
 	DuplicateOf             int            `json:"duplicate_of"`
 	DueDate                 string         `json:"due_date"`
 	ExchangeRate            float64        `json:"exch_rate"`
 	ExternalID              string         `json:"external_id"`
-	FinalBalance            float64        `json:"final_balance"`
+	FinalBalance            Amount         `json:"final_balance"`
 	GuestCount              string         `json:"guest_count"`
 	ID                      int            `json:"id"`
 	ImgFileName             string         `json:"img_file_name"`
 	ImgThumbnailURL         string         `json:"img_thumbnail_url"`
 	ImgURL                  string         `json:"img_url"`
 	Incoterms               string         `json:"incoterms"`
-	Insurance               float64        `json:"insurance"`
+	Insurance               Amount         `json:"insurance"`
 	InvoiceNumber           string         `json:"invoice_number"`
 	IsApproved              bool           `json:"is_approved"`
 	IsDocument              bool           `json:"is_document"`
@@ -205,39 +213,49 @@ type Document struct {
 	Notes                   string         `json:"notes"`
 	OCRText                 string         `json:"ocr_text"`
 	OrderDate               string         `json:"order_date"`
+	PageRange               *PageRange     `json:"page_range,omitempty"`
 	Payment                 PaymentsInfo   `json:"payment"`
 	PaymentLinks            []string       `json:"payment_links"`
 	PDFURL                  string         `json:"pdf_url"`
-	PreviousBalance         float64        `json:"previous_balance"`
+	PhoneNumber             string         `json:"phone_number"`
+	PreviousBalance         Amount         `json:"previous_balance"`
 	PurchaseOrderNumber     string         `json:"purchase_order_number"`
 	ReferenceNumber         string         `json:"reference_number"`
-	Rounding                float64        `json:"rounding"`
+	Rounding                Amount         `json:"rounding"`
 	ServerName              string         `json:"server_name"`
 	ServiceEndDate          string         `json:"service_end_date"`
 	ServiceStartDate        string         `json:"service_start_date"`
 	ShipDate                string         `json:"ship_date"`
-	Shipping                float64        `json:"shipping"`
+	Shipping                Amount         `json:"shipping"`
 	ShipTo                  ToField        `json:"ship_to"`
 	Status                  DocumentStatus `json:"status"`
 	StoreNumber             string         `json:"store_number"`
-	Subtotal                float64        `json:"subtotal"`
+	Subtotal                Amount         `json:"subtotal"`
 	Tags                    []Tag          `json:"tags"`
-	Tax                     float64        `json:"tax"`
+	Tax                     Amount         `json:"tax"`
 	TaxLines                []TaxLine      `json:"tax_lines"`
-	Tip                     float64        `json:"tip"`
-	Total                   float64        `json:"total"`
+	Tip                     Amount         `json:"tip"`
+	Total                   Amount         `json:"total"`
 	TotalQuantity           float64        `json:"total_quantity"`
 	TotalWeight             string         `json:"total_weight"`
 	TrackingNumber          string         `json:"tracking_number"`
 	TrackingNumbers         []string       `json:"tracking_numbers"`
 	Updated                 string         `json:"updated_date"`
+	VATNumber               string         `json:"vat_number"`
 	VendingPerson           string         `json:"vending_person"`
 	VendingPersonNumber     string         `json:"vending_person_number"`
 	Vendor                  Vendor         `json:"vendor"`
 	Vendors                 []string       `json:"vendors"`
+	VendorIban              string         `json:"vendor_iban"`
 	VINNumber               string         `json:"vin_number"`
 	Warnings                []string       `json:"warnings"`
 	Weights                 []string       `json:"weights"`
+
+	// Extra holds the fields of the response that Document does not declare.
+	Extra map[string]json.RawMessage `json:"-"`
+
+	// Raw holds the JSON the document was decoded from.
+	Raw json.RawMessage `json:"-"`
 }
 
 // ToField describes the to field response.
@@ -249,6 +267,8 @@ type ToField struct {
 	VATNumber     string        `json:"vat_number"`
 	PhoneNumber   string        `json:"phone_number"`
 	RegNumber     string        `json:"reg_number"`
+
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // ParsedAddress describes the parsed address response.
@@ -271,6 +291,8 @@ type ParsedAddress struct {
 	StateDistrict string `json:"state_district"`
 	CountryRegion string `json:"country_region"`
 	WorldRegion   string `json:"world_region"`
+
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // PaymentsInfo describes the payment response.
@@ -279,6 +301,8 @@ type PaymentsInfo struct {
 	DisplayName string `json:"display_name"`
 	Terms       string `json:"terms"`
 	Type        string `json:"type"`
+
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // LineItems describes the line items in a document response.
@@ -292,37 +316,39 @@ type LineItem struct {
 	CountryOfOrigin       string      `json:"country_of_origin"`
 	Date                  string      `json:"date"`
 	Description           string      `json:"description"`
-	Discount              float64     `json:"discount"`
-	DiscountPrice         float64     `json:"discount_price"`
+	Discount              Amount      `json:"discount"`
+	DiscountPrice         Amount      `json:"discount_price"`
 	DiscountRate          float64     `json:"discount_rate"`
 	EndDate               string      `json:"end_date"`
 	FullDescription       string      `json:"full_description"`
-	GrossTotal            float64     `json:"gross_total"`
+	GrossTotal            Amount      `json:"gross_total"`
 	HSN                   string      `json:"hsn"`
 	ID                    int         `json:"id"`
 	Lot                   string      `json:"lot"`
 	Manufacturer          string      `json:"manufacturer"`
-	NetTotal              float64     `json:"net_total"`
+	NetTotal              Amount      `json:"net_total"`
 	NormalizedDescription string      `json:"normalized_description"`
 	Order                 int         `json:"order"`
-	Price                 float64     `json:"price"`
+	Price                 Amount      `json:"price"`
 	ProductInfo           ProductInfo `json:"product_info"`
 	Quantity              float64     `json:"quantity"`
 	Reference             string      `json:"reference"`
 	Section               string      `json:"section"`
 	SKU                   string      `json:"sku"`
 	StartDate             string      `json:"start_date"`
-	Subtotal              float64     `json:"subtotal"`
+	Subtotal              Amount      `json:"subtotal"`
 	Tags                  []string    `json:"tags"`
-	Tax                   float64     `json:"tax"`
+	Tax                   Amount      `json:"tax"`
 	TaxCode               string      `json:"tax_code"`
 	TaxRate               float64     `json:"tax_rate"`
 	Text                  string      `json:"text"`
-	Total                 float64     `json:"total"`
+	Total                 Amount      `json:"total"`
 	Type                  string      `json:"type"`
 	UnitOfMeasure         string      `json:"unit_of_measure"`
 	UPC                   string      `json:"upc"`
 	Weight                string      `json:"weight"`
+
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // ProductInfo describes the product info in a document response.
@@ -330,17 +356,21 @@ type ProductInfo struct {
 	Brand               *string  `json:"brand"`
 	Category            []string `json:"category"`
 	ExpandedDescription *string  `json:"expanded_description"`
+
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // TaxLine describes the tax line response.
 type TaxLine struct {
-	Base           float64 `json:"base"`
+	Base           Amount  `json:"base"`
 	Code           string  `json:"code"`
 	Name           string  `json:"name"`
 	Order          int     `json:"order"`
 	Rate           float64 `json:"rate"`
-	Total          float64 `json:"total"`
-	TotalInclusive float64 `json:"total_inclusive"`
+	Total          Amount  `json:"total"`
+	TotalInclusive Amount  `json:"total_inclusive"`
+
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // Vendor describes the vendor response.
@@ -369,6 +399,8 @@ type Vendor struct {
 	Lat             float64       `json:"lat"`
 	Lng             float64       `json:"lng"`
 	Type            string        `json:"type"`
+
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // Tags describes the tags response.
@@ -380,6 +412,8 @@ type Tags struct {
 type Tag struct {
 	ID   int    `json:"id"`
 	Name string `json:"name"`
+
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // TagOptions describes query parameters to update a tag in a document.
@@ -387,50 +421,6 @@ type TagOptions struct {
 	Name string `json:"name"`
 }
 
-// DetailedField represents a field with confidence scores and metadata
-type DetailedField struct {
-	Enriched       *bool     `json:"enriched"`
-	Value          *string   `json:"value,omitempty"`
-	Score          *float64  `json:"score,omitempty"`
-	OCRScore       *float64  `json:"ocr_score,omitempty"`
-	BoundingBox    []float64 `json:"bounding_box,omitempty"`
-	BoundingRegion []float64 `json:"bounding_region,omitempty"`
-	Rotation       int       `json:"rotation,omitempty"`
-}
-
-// DetailedFloatField represents a numeric field with confidence scores
-type DetailedFloatField struct {
-	Enriched       *bool     `json:"enriched"`
-	Value          *float64  `json:"value,omitempty"`
-	Score          *float64  `json:"score,omitempty"`
-	OCRScore       *float64  `json:"ocr_score,omitempty"`
-	BoundingBox    []float64 `json:"bounding_box,omitempty"`
-	BoundingRegion []float64 `json:"bounding_region,omitempty"`
-	Rotation       int       `json:"rotation,omitempty"`
-}
-
-// DetailedDateField represents a date field with confidence scores
-type DetailedDateField struct {
-	Enriched       *bool     `json:"enriched"`
-	Value          *string   `json:"value,omitempty"` // ISO 8601 date format
-	Score          *float64  `json:"score,omitempty"`
-	OCRScore       *float64  `json:"ocr_score,omitempty"`
-	BoundingBox    []float64 `json:"bounding_box,omitempty"`
-	BoundingRegion []float64 `json:"bounding_region,omitempty"`
-	Rotation       int       `json:"rotation,omitempty"`
-}
-
-// DetailedBoolField represents a boolean field with confidence scores
-type DetailedBoolField struct {
-	Enriched       *bool     `json:"enriched"`
-	Value          *bool     `json:"value,omitempty"`
-	Score          *float64  `json:"score,omitempty"`
-	OCRScore       *float64  `json:"ocr_score,omitempty"`
-	BoundingBox    []float64 `json:"bounding_box,omitempty"`
-	BoundingRegion []float64 `json:"bounding_region,omitempty"`
-	Rotation       int       `json:"rotation,omitempty"`
-}
-
 // DetailedVendor extends Vendor with detailed fields
 type DetailedVendor struct {
 	ABNNumber       *DetailedField `json:"abn_number,omitempty"`
@@ -457,6 +447,8 @@ type DetailedVendor struct {
 	Lat             *float64       `json:"lat,omitempty"`
 	Lng             *float64       `json:"lng,omitempty"`
 	Type            *DetailedField `json:"type"`
+
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // DetailedBillTo represents bill_to information with confidence scores
@@ -468,6 +460,8 @@ type DetailedToField struct {
 	VATNumber     *DetailedField `json:"vat_number"`
 	PhoneNumber   *DetailedField `json:"phone_number"`
 	RegNumber     *DetailedField `json:"reg_number"`
+
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // DetailedPayment represents payment information with confidence scores
@@ -476,28 +470,49 @@ type DetailedPayment struct {
 	DisplayName *string        `json:"display_name"`
 	Terms       *DetailedField `json:"terms"`
 	Type        *DetailedField `json:"type"`
+
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // DetailedLineItem extends LineItem with confidence scores
 type DetailedLineItem struct {
-	Category      *DetailedField      `json:"category"`
-	Date          *DetailedDateField  `json:"date"`
-	Description   *DetailedField      `json:"description"`
-	Discount      *DetailedFloatField `json:"discount"`
-	ID            int                 `json:"id"`
-	Order         int                 `json:"order"`
-	Price         *DetailedFloatField `json:"price"`
-	Quantity      *DetailedFloatField `json:"quantity"`
-	Reference     *DetailedField      `json:"reference"`
-	Section       *DetailedField      `json:"section"`
-	SKU           *DetailedField      `json:"sku"`
-	UPC           *DetailedField      `json:"upc"`
-	Tags          []string            `json:"tags"`
-	Tax           *DetailedFloatField `json:"tax"`
-	TaxRate       *DetailedFloatField `json:"tax_rate"`
-	Total         *DetailedFloatField `json:"total"`
-	Type          *string             `json:"type"`
-	UnitOfMeasure *DetailedField      `json:"unit_of_measure"`
+	Category              *DetailedField      `json:"category"`
+	CountryOfOrigin       *DetailedField      `json:"country_of_origin"`
+	Date                  *DetailedDateField  `json:"date"`
+	Description           *DetailedField      `json:"description"`
+	Discount              *DetailedFloatField `json:"discount"`
+	DiscountPrice         *DetailedFloatField `json:"discount_price"`
+	DiscountRate          *DetailedFloatField `json:"discount_rate"`
+	EndDate               *DetailedDateField  `json:"end_date"`
+	FullDescription       *DetailedField      `json:"full_description"`
+	GrossTotal            *DetailedFloatField `json:"gross_total"`
+	HSN                   *DetailedField      `json:"hsn"`
+	ID                    int                 `json:"id"`
+	Lot                   *DetailedField      `json:"lot"`
+	Manufacturer          *DetailedField      `json:"manufacturer"`
+	NetTotal              *DetailedFloatField `json:"net_total"`
+	NormalizedDescription *DetailedField      `json:"normalized_description"`
+	Order                 int                 `json:"order"`
+	Price                 *DetailedFloatField `json:"price"`
+	ProductInfo           *ProductInfo        `json:"product_info"`
+	Quantity              *DetailedFloatField `json:"quantity"`
+	Reference             *DetailedField      `json:"reference"`
+	Section               *DetailedField      `json:"section"`
+	SKU                   *DetailedField      `json:"sku"`
+	StartDate             *DetailedDateField  `json:"start_date"`
+	Subtotal              *DetailedFloatField `json:"subtotal"`
+	UPC                   *DetailedField      `json:"upc"`
+	Tags                  []string            `json:"tags"`
+	Tax                   *DetailedFloatField `json:"tax"`
+	TaxCode               *DetailedField      `json:"tax_code"`
+	TaxRate               *DetailedFloatField `json:"tax_rate"`
+	Text                  string              `json:"text"`
+	Total                 *DetailedFloatField `json:"total"`
+	Type                  *string             `json:"type"`
+	UnitOfMeasure         *DetailedField      `json:"unit_of_measure"`
+	Weight                *DetailedField      `json:"weight"`
+
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // DetailedTaxLine extends TaxLine with confidence scores
@@ -509,6 +524,8 @@ type DetailedTaxLine struct {
 	Base           *DetailedFloatField `json:"base"`
 	Code           *DetailedField      `json:"code"`
 	TotalInclusive *DetailedFloatField `json:"total_inclusive"`
+
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 type DetailedDocuments struct {
@@ -518,52 +535,92 @@ type DetailedDocuments struct {
 
 // DetailedDocument extends Document with detailed field information
 type DetailedDocument struct {
-	ABNNumber           *DetailedField      `json:"abn_number"`
-	AccountNumber       *DetailedField      `json:"account_number"`
-	Barcodes            []Barcode           `json:"barcodes"`
-	BillTo              DetailedToField     `json:"bill_to"`
-	CardNumber          *DetailedField      `json:"card_number"`
-	Category            *DetailedField      `json:"category"`
-	Created             string              `json:"created_date"`
-	CurrencyCode        *DetailedField      `json:"currency_code"`
-	Date                *DetailedDateField  `json:"date"`
-	DeliveryDate        *DetailedDateField  `json:"delivery_date"`
-	Discount            *DetailedFloatField `json:"discount"`
-	ReferenceNumber     *string             `json:"reference_number"`
-	DueDate             *DetailedDateField  `json:"due_date"`
-	ExternalID          *string             `json:"external_id"`
-	ID                  int                 `json:"id"`
-	ImgFileName         string              `json:"img_file_name"`
-	ImgThumbnailURL     string              `json:"img_thumbnail_url"`
-	ImgURL              string              `json:"img_url"`
-	Insurance           *DetailedFloatField `json:"insurance"`
-	InvoiceNumber       *DetailedField      `json:"invoice_number"`
-	IsDuplicate         bool                `json:"is_duplicate"`
-	LineItems           []LineItem          `json:"line_items"`
-	LineItemsWithScores []DetailedLineItem  `json:"line_items_with_scores"`
-	OCRText             string              `json:"ocr_text"`
-	OrderDate           *DetailedDateField  `json:"order_date"`
-	Payment             *DetailedPayment    `json:"payment"`
-	PhoneNumber         *DetailedField      `json:"phone_number"`
-	PurchaseOrderNumber *DetailedField      `json:"purchase_order_number"`
-	Rounding            *DetailedFloatField `json:"rounding"`
-	ServiceEndDate      *DetailedDateField  `json:"service_end_date"`
-	ServiceStartDate    *DetailedDateField  `json:"service_start_date"`
-	ShipDate            *DetailedDateField  `json:"ship_date"`
-	ShipTo              DetailedToField     `json:"ship_to"`
-	Status              DocumentStatus      `json:"status"`
-	StoreNumber         *DetailedField      `json:"store_number"`
-	Subtotal            *DetailedFloatField `json:"subtotal"`
-	Tags                []Tag               `json:"tags"`
-	Tax                 *DetailedFloatField `json:"tax"`
-	TaxLines            []TaxLine           `json:"tax_lines"`
-	TaxLinesWithScores  []DetailedTaxLine   `json:"tax_lines_with_scores"`
-	Tip                 *DetailedFloatField `json:"tip"`
-	Total               *DetailedFloatField `json:"total"`
-	TotalWeight         *DetailedField      `json:"total_weight"`
-	TrackingNumber      *DetailedField      `json:"tracking_number"`
-	Updated             *string             `json:"updated_date"`
-	VATNumber           *DetailedField      `json:"vat_number"`
-	Vendor              *DetailedVendor     `json:"vendor"`
-	VendorIban          *DetailedField      `json:"vendor_iban"`
+	ABNNumber               *DetailedField      `json:"abn_number"`
+	AccountingEntryType     string              `json:"accounting_entry_type"`
+	AccountNumber           *DetailedField      `json:"account_number"`
+	Balance                 *DetailedFloatField `json:"balance"`
+	Barcodes                []Barcode           `json:"barcodes"`
+	BillTo                  DetailedToField     `json:"bill_to"`
+	CardNumber              *DetailedField      `json:"card_number"`
+	Cashback                *DetailedFloatField `json:"cashback"`
+	Category                *DetailedField      `json:"category"`
+	Created                 string              `json:"created_date"`
+	CountryCode             *DetailedField      `json:"country_code"`
+	CurrencyCode            *DetailedField      `json:"currency_code"`
+	Date                    *DetailedDateField  `json:"date"`
+	DefaultCategory         *DetailedField      `json:"default_category"`
+	DeliveryDate            *DetailedDateField  `json:"delivery_date"`
+	DeliveryNoteNumber      *DetailedField      `json:"delivery_note_number"`
+	Discount                *DetailedFloatField `json:"discount"`
+	DocumentReferenceNumber *DetailedField      `json:"document_reference_number"`
+	DocumentTitle           *DetailedField      `json:"document_title"`
+	DuplicateOf             int                 `json:"duplicate_of"`
+	DueDate                 *DetailedDateField  `json:"due_date"`
+	ExchangeRate            float64             `json:"exch_rate"`
+	ExternalID              *string             `json:"external_id"`
+	FinalBalance            *DetailedFloatField `json:"final_balance"`
+	GuestCount              *DetailedField      `json:"guest_count"`
+	ID                      int                 `json:"id"`
+	ImgFileName             string              `json:"img_file_name"`
+	ImgThumbnailURL         string              `json:"img_thumbnail_url"`
+	ImgURL                  string              `json:"img_url"`
+	Incoterms               *DetailedField      `json:"incoterms"`
+	Insurance               *DetailedFloatField `json:"insurance"`
+	InvoiceNumber           *DetailedField      `json:"invoice_number"`
+	IsApproved              bool                `json:"is_approved"`
+	IsDocument              bool                `json:"is_document"`
+	IsDuplicate             bool                `json:"is_duplicate"`
+	IsMoneyIn               *DetailedBoolField  `json:"is_money_in"`
+	IsTransaction           *DetailedBoolField  `json:"is_transaction"`
+	LicensePlateNumber      *DetailedField      `json:"license_plate_number"`
+	LineItems               []LineItem          `json:"line_items"`
+	LineItemsWithScores     []DetailedLineItem  `json:"line_items_with_scores"`
+	Model                   string              `json:"model"`
+	Notes                   *string             `json:"notes"`
+	OCRText                 string              `json:"ocr_text"`
+	OrderDate               *DetailedDateField  `json:"order_date"`
+	PageRange               *PageRange          `json:"page_range,omitempty"`
+	Payment                 *DetailedPayment    `json:"payment"`
+	PaymentLinks            []string            `json:"payment_links"`
+	PDFURL                  string              `json:"pdf_url"`
+	PhoneNumber             *DetailedField      `json:"phone_number"`
+	PreviousBalance         *DetailedFloatField `json:"previous_balance"`
+	PurchaseOrderNumber     *DetailedField      `json:"purchase_order_number"`
+	ReferenceNumber         *string             `json:"reference_number"`
+	Rounding                *DetailedFloatField `json:"rounding"`
+	ServerName              *DetailedField      `json:"server_name"`
+	ServiceEndDate          *DetailedDateField  `json:"service_end_date"`
+	ServiceStartDate        *DetailedDateField  `json:"service_start_date"`
+	ShipDate                *DetailedDateField  `json:"ship_date"`
+	Shipping                *DetailedFloatField `json:"shipping"`
+	ShipTo                  DetailedToField     `json:"ship_to"`
+	Status                  DocumentStatus      `json:"status"`
+	StoreNumber             *DetailedField      `json:"store_number"`
+	Subtotal                *DetailedFloatField `json:"subtotal"`
+	Tags                    []Tag               `json:"tags"`
+	Tax                     *DetailedFloatField `json:"tax"`
+	TaxLines                []TaxLine           `json:"tax_lines"`
+	TaxLinesWithScores      []DetailedTaxLine   `json:"tax_lines_with_scores"`
+	Tip                     *DetailedFloatField `json:"tip"`
+	Total                   *DetailedFloatField `json:"total"`
+	TotalQuantity           *DetailedFloatField `json:"total_quantity"`
+	TotalWeight             *DetailedField      `json:"total_weight"`
+	TrackingNumber          *DetailedField      `json:"tracking_number"`
+	TrackingNumbers         []*DetailedField    `json:"tracking_numbers"`
+	Updated                 *string             `json:"updated_date"`
+	VATNumber               *DetailedField      `json:"vat_number"`
+	VendingPerson           *DetailedField      `json:"vending_person"`
+	VendingPersonNumber     *DetailedField      `json:"vending_person_number"`
+	Vendor                  *DetailedVendor     `json:"vendor"`
+	VendorIban              *DetailedField      `json:"vendor_iban"`
+	Vendors                 []*DetailedField    `json:"vendors"`
+	VINNumber               *DetailedField      `json:"vin_number"`
+	Warnings                []string            `json:"warnings"`
+	Weights                 []*DetailedField    `json:"weights"`
+
+	// Extra holds the fields of the response that DetailedDocument does not declare.
+	Extra map[string]json.RawMessage `json:"-"`
+
+	// Raw holds the JSON the document was decoded from.
+	Raw json.RawMessage `json:"-"`
 }
@@ -0,0 +1,58 @@
+package scheme
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// DocumentBatchStatus describes the processing status of a document batch.
+type DocumentBatchStatus string
+
+const (
+	BatchInProgress DocumentBatchStatus = "in_progress"
+	BatchProcessed  DocumentBatchStatus = "processed"
+	BatchFailed     DocumentBatchStatus = "failed"
+)
+
+// DocumentBatch describes the response of the split-and-process API, a file
+// split into one document per detected invoice or receipt.
+type DocumentBatch struct {
+	ID        int                 `json:"id"`
+	Status    DocumentBatchStatus `json:"status"`
+	Created   string              `json:"created_date"`
+	Updated   string              `json:"updated_date"`
+	PDFURL    string              `json:"pdf_url"`
+	Documents []BatchDocument     `json:"documents"`
+
+	// Extra holds the fields of the response that DocumentBatch does not declare.
+	Extra map[string]json.RawMessage `json:"-"`
+
+	// Raw holds the JSON the batch was decoded from.
+	Raw json.RawMessage `json:"-"`
+}
+
+// BatchDocument describes a child document of a document batch.
+type BatchDocument struct {
+	ID        int       `json:"id"`
+	PageRange PageRange `json:"page_range"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// PageRange describes the pages of a file a document was split from. Pages
+// are numbered from 1 and both ends are inclusive.
+type PageRange struct {
+	First int `json:"first"`
+	Last  int `json:"last"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// String returns the page range as "3-5", or "3" for a single page.
+func (p PageRange) String() string {
+	if p.First == p.Last {
+		return fmt.Sprintf("%d", p.First)
+	}
+
+	return fmt.Sprintf("%d-%d", p.First, p.Last)
+}
@@ -0,0 +1,149 @@
+package scheme
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+
+	"github.com/pkg/errors"
+)
+
+// DriftKind names how a response differs from the type it is decoded into.
+type DriftKind string
+
+const (
+	// UnknownField is a field that the type does not declare. It is kept in
+	// the Extra field of response types.
+	UnknownField DriftKind = "unknown_field"
+
+	// TypeMismatch is a value that can not be decoded into the field it
+	// belongs to, e.g. a number where a string is expected.
+	TypeMismatch DriftKind = "type_mismatch"
+)
+
+// Drift is a difference between a response and the type it is decoded into.
+type Drift struct {
+	Kind DriftKind `json:"kind"`
+
+	// Path is the JSON path of the value, e.g. "guest_count" or
+	// "line_items[2].total".
+	Path string `json:"path"`
+
+	// JSONType is the type of the value in the response: "object", "array",
+	// "string", "number" or "boolean".
+	JSONType string `json:"json_type"`
+
+	// GoType is the type of the field the value does not fit, e.g. "string".
+	// It is empty for unknown fields.
+	GoType string `json:"go_type,omitempty"`
+
+	Value json.RawMessage `json:"value"`
+}
+
+// String returns a short description of the drift, e.g. "guest_count: number
+// where string is expected".
+func (d Drift) String() string {
+	if d.Kind == TypeMismatch {
+		return fmt.Sprintf("%s: %s where %s is expected", d.Path, d.JSONType, d.GoType)
+	}
+
+	return fmt.Sprintf("%s: unknown %s field", d.Path, d.JSONType)
+}
+
+// DetectDrift compares the JSON data with the type of v, usually a pointer to
+// a response type, and returns the fields that type does not declare and the
+// values that can not be decoded into their field, in the order of the
+// fields of each object, sorted by name. It fails if data is not valid JSON.
+func DetectDrift(data []byte, v any) ([]Drift, error) {
+	if !json.Valid(data) {
+		return nil, errors.New("fail to detect drift: invalid JSON")
+	}
+
+	var drifts []Drift
+	detectDrift(reflect.TypeOf(v), data, "", &drifts)
+
+	return drifts, nil
+}
+
+// detectDrift appends to drifts the differences between data, the JSON value
+// at path, and the type t.
+func detectDrift(t reflect.Type, data json.RawMessage, path string, drifts *[]Drift) {
+	data = bytes.TrimSpace(data)
+	if t == nil || bytes.Equal(data, []byte("null")) {
+		return
+	}
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	switch {
+	case isObjectType(t) && data[0] == '{':
+		fields := map[string]json.RawMessage{}
+		if err := json.Unmarshal(data, &fields); err != nil {
+			break
+		}
+		names := make([]string, 0, len(fields))
+		for name := range fields {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		c := codecOf(t)
+		for _, name := range names {
+			f, ok := c.lookup(name)
+			if !ok {
+				*drifts = append(*drifts, Drift{
+					Kind:     UnknownField,
+					Path:     joinPath(path, name),
+					JSONType: jsonType(fields[name]),
+					Value:    fields[name],
+				})
+				continue
+			}
+			detectDrift(f.typ, fields[name], joinPath(path, name), drifts)
+		}
+		return
+
+	case t.Kind() == reflect.Slice && t.Elem().Kind() != reflect.Uint8 && data[0] == '[':
+		var items []json.RawMessage
+		if err := json.Unmarshal(data, &items); err != nil {
+			break
+		}
+		for i, item := range items {
+			detectDrift(t.Elem(), item, path+"["+strconv.Itoa(i)+"]", drifts)
+		}
+		return
+	}
+
+	if err := json.Unmarshal(data, reflect.New(t).Interface()); err != nil {
+		*drifts = append(*drifts, Drift{
+			Kind:     TypeMismatch,
+			Path:     path,
+			JSONType: jsonType(data),
+			GoType:   t.String(),
+			Value:    data,
+		})
+	}
+}
+
+var unmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+
+// isObjectType reports whether the fields of JSON objects decoded into the
+// type t can be checked one by one: t is a struct decoded field by field, a
+// response type keeping unknown fields in Extra, or an instance of Detailed.
+func isObjectType(t reflect.Type) bool {
+	if t.Kind() != reflect.Struct {
+		return false
+	}
+	if !reflect.PointerTo(t).Implements(unmarshalerType) {
+		return true
+	}
+	if f, ok := t.FieldByName("Extra"); ok && f.Tag.Get("json") == "-" {
+		return true
+	}
+
+	return reflect.PointerTo(t).Implements(detailedFieldType)
+}
@@ -0,0 +1,67 @@
+package scheme
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitDetectDrift(t *testing.T) {
+	drifts, err := DetectDrift([]byte(`{
+		"id": 1,
+		"guest_count": 3,
+		"total": "abc",
+		"tracking_numbers": ["1Z", {"value": "1Y"}],
+		"line_items": [{"quantity": "2", "allergens": []}],
+		"vendor": "Walgreens"
+	}`), &Document{})
+	assert.NoError(t, err)
+
+	descriptions := []string{}
+	for _, d := range drifts {
+		descriptions = append(descriptions, d.String())
+	}
+	assert.Equal(t, []string{
+		"guest_count: number where string is expected",
+		"line_items[0].allergens: unknown array field",
+		"line_items[0].quantity: string where float64 is expected",
+		"total: string where scheme.Amount is expected",
+		"tracking_numbers[1]: object where string is expected",
+		"vendor: string where scheme.Vendor is expected",
+	}, descriptions)
+
+	// Detailed fields are checked whether they are objects or bare values.
+	drifts, err = DetectDrift([]byte(`{"total": {"value": "12", "score": 1, "page": 2}, "tip": 1.5, "tax": "x"}`), &DetailedDocument{})
+	assert.NoError(t, err)
+	assert.Equal(t, []Drift{
+		{Kind: TypeMismatch, Path: "tax", JSONType: "string", GoType: "scheme.Detailed[float64]", Value: json.RawMessage(`"x"`)},
+		{Kind: UnknownField, Path: "total.page", JSONType: "number", Value: json.RawMessage(`2`)},
+		{Kind: TypeMismatch, Path: "total.value", JSONType: "string", GoType: "float64", Value: json.RawMessage(`"12"`)},
+	}, drifts)
+
+	_, err = DetectDrift([]byte(`{"id":`), &Document{})
+	assert.EqualError(t, err, "fail to detect drift: invalid JSON")
+}
+
+func TestUnitDetectDrift_Fixtures(t *testing.T) {
+	// Fields of embedded structs are declared, and any-documents are open.
+	drifts, err := DetectDrift([]byte(`{"id": 7, "tax_year": "2023"}`), &W2Form{})
+	assert.NoError(t, err)
+	assert.Empty(t, drifts)
+	drifts, err = DetectDrift([]byte(`{"id": 7, "first_name": "JANICE"}`), &AnyDocument{})
+	assert.NoError(t, err)
+	assert.Empty(t, drifts)
+
+	// The detailed receipt has fields the SDK does not declare, but every
+	// declared field has the type the API sends.
+	b, err := os.ReadFile("../testdata/detailed_receipt_public.json")
+	assert.NoError(t, err)
+	drifts, err = DetectDrift(b, &DetailedDocument{})
+	assert.NoError(t, err)
+	assert.NotEmpty(t, drifts)
+	for _, d := range drifts {
+		assert.Equal(t, UnknownField, d.Kind, d.String())
+	}
+}
@@ -0,0 +1,507 @@
+package scheme
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// Response types keep the fields of a response that they do not declare in
+// Extra, keyed by JSON name, so that fields added to the API are not lost
+// until the SDK catches up. Extra is nil if there are none, and its fields
+// are encoded back after the declared ones. Document types also keep the
+// JSON they were decoded from in Raw. Their JSON methods all go through
+// unmarshalObject and marshalObject, which decode and encode them field by
+// field.
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (d *DocumentsMeta) UnmarshalJSON(data []byte) error { return unmarshalObject(data, d) }
+
+// MarshalJSON implements json.Marshaler.
+func (d DocumentsMeta) MarshalJSON() ([]byte, error) { return marshalObject(&d) }
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (b *Barcode) UnmarshalJSON(data []byte) error { return unmarshalObject(data, b) }
+
+// MarshalJSON implements json.Marshaler.
+func (b Barcode) MarshalJSON() ([]byte, error) { return marshalObject(&b) }
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (d *Document) UnmarshalJSON(data []byte) error { return unmarshalObject(data, d) }
+
+// MarshalJSON implements json.Marshaler.
+func (d Document) MarshalJSON() ([]byte, error) { return marshalObject(&d) }
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (t *ToField) UnmarshalJSON(data []byte) error { return unmarshalObject(data, t) }
+
+// MarshalJSON implements json.Marshaler.
+func (t ToField) MarshalJSON() ([]byte, error) { return marshalObject(&t) }
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (p *ParsedAddress) UnmarshalJSON(data []byte) error { return unmarshalObject(data, p) }
+
+// MarshalJSON implements json.Marshaler.
+func (p ParsedAddress) MarshalJSON() ([]byte, error) { return marshalObject(&p) }
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (p *PaymentsInfo) UnmarshalJSON(data []byte) error { return unmarshalObject(data, p) }
+
+// MarshalJSON implements json.Marshaler.
+func (p PaymentsInfo) MarshalJSON() ([]byte, error) { return marshalObject(&p) }
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (l *LineItem) UnmarshalJSON(data []byte) error { return unmarshalObject(data, l) }
+
+// MarshalJSON implements json.Marshaler.
+func (l LineItem) MarshalJSON() ([]byte, error) { return marshalObject(&l) }
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (p *ProductInfo) UnmarshalJSON(data []byte) error { return unmarshalObject(data, p) }
+
+// MarshalJSON implements json.Marshaler.
+func (p ProductInfo) MarshalJSON() ([]byte, error) { return marshalObject(&p) }
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (t *TaxLine) UnmarshalJSON(data []byte) error { return unmarshalObject(data, t) }
+
+// MarshalJSON implements json.Marshaler.
+func (t TaxLine) MarshalJSON() ([]byte, error) { return marshalObject(&t) }
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *Vendor) UnmarshalJSON(data []byte) error { return unmarshalObject(data, v) }
+
+// MarshalJSON implements json.Marshaler.
+func (v Vendor) MarshalJSON() ([]byte, error) { return marshalObject(&v) }
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (t *Tag) UnmarshalJSON(data []byte) error { return unmarshalObject(data, t) }
+
+// MarshalJSON implements json.Marshaler.
+func (t Tag) MarshalJSON() ([]byte, error) { return marshalObject(&t) }
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (d *DetailedVendor) UnmarshalJSON(data []byte) error { return unmarshalObject(data, d) }
+
+// MarshalJSON implements json.Marshaler.
+func (d DetailedVendor) MarshalJSON() ([]byte, error) { return marshalObject(&d) }
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (d *DetailedToField) UnmarshalJSON(data []byte) error { return unmarshalObject(data, d) }
+
+// MarshalJSON implements json.Marshaler.
+func (d DetailedToField) MarshalJSON() ([]byte, error) { return marshalObject(&d) }
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (d *DetailedPayment) UnmarshalJSON(data []byte) error { return unmarshalObject(data, d) }
+
+// MarshalJSON implements json.Marshaler.
+func (d DetailedPayment) MarshalJSON() ([]byte, error) { return marshalObject(&d) }
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (d *DetailedLineItem) UnmarshalJSON(data []byte) error { return unmarshalObject(data, d) }
+
+// MarshalJSON implements json.Marshaler.
+func (d DetailedLineItem) MarshalJSON() ([]byte, error) { return marshalObject(&d) }
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (d *DetailedTaxLine) UnmarshalJSON(data []byte) error { return unmarshalObject(data, d) }
+
+// MarshalJSON implements json.Marshaler.
+func (d DetailedTaxLine) MarshalJSON() ([]byte, error) { return marshalObject(&d) }
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (d *DetailedDocument) UnmarshalJSON(data []byte) error { return unmarshalObject(data, d) }
+
+// MarshalJSON implements json.Marshaler.
+func (d DetailedDocument) MarshalJSON() ([]byte, error) { return marshalObject(&d) }
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (b *BankStatement) UnmarshalJSON(data []byte) error { return unmarshalObject(data, b) }
+
+// MarshalJSON implements json.Marshaler.
+func (b BankStatement) MarshalJSON() ([]byte, error) { return marshalObject(&b) }
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (b *BankStatementSummary) UnmarshalJSON(data []byte) error { return unmarshalObject(data, b) }
+
+// MarshalJSON implements json.Marshaler.
+func (b BankStatementSummary) MarshalJSON() ([]byte, error) { return marshalObject(&b) }
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (b *BankTransaction) UnmarshalJSON(data []byte) error { return unmarshalObject(data, b) }
+
+// MarshalJSON implements json.Marshaler.
+func (b BankTransaction) MarshalJSON() ([]byte, error) { return marshalObject(&b) }
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (b *BusinessCard) UnmarshalJSON(data []byte) error { return unmarshalObject(data, b) }
+
+// MarshalJSON implements json.Marshaler.
+func (b BusinessCard) MarshalJSON() ([]byte, error) { return marshalObject(&b) }
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (c *ContactPhone) UnmarshalJSON(data []byte) error { return unmarshalObject(data, c) }
+
+// MarshalJSON implements json.Marshaler.
+func (c ContactPhone) MarshalJSON() ([]byte, error) { return marshalObject(&c) }
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (c *ContactAddress) UnmarshalJSON(data []byte) error { return unmarshalObject(data, c) }
+
+// MarshalJSON implements json.Marshaler.
+func (c ContactAddress) MarshalJSON() ([]byte, error) { return marshalObject(&c) }
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (s *SocialHandle) UnmarshalJSON(data []byte) error { return unmarshalObject(data, s) }
+
+// MarshalJSON implements json.Marshaler.
+func (s SocialHandle) MarshalJSON() ([]byte, error) { return marshalObject(&s) }
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (c *Check) UnmarshalJSON(data []byte) error { return unmarshalObject(data, c) }
+
+// MarshalJSON implements json.Marshaler.
+func (c Check) MarshalJSON() ([]byte, error) { return marshalObject(&c) }
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (c *CheckMICR) UnmarshalJSON(data []byte) error { return unmarshalObject(data, c) }
+
+// MarshalJSON implements json.Marshaler.
+func (c CheckMICR) MarshalJSON() ([]byte, error) { return marshalObject(&c) }
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (c *CheckEndorsement) UnmarshalJSON(data []byte) error { return unmarshalObject(data, c) }
+
+// MarshalJSON implements json.Marshaler.
+func (c CheckEndorsement) MarshalJSON() ([]byte, error) { return marshalObject(&c) }
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (c *CheckStub) UnmarshalJSON(data []byte) error { return unmarshalObject(data, c) }
+
+// MarshalJSON implements json.Marshaler.
+func (c CheckStub) MarshalJSON() ([]byte, error) { return marshalObject(&c) }
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (c *Classification) UnmarshalJSON(data []byte) error { return unmarshalObject(data, c) }
+
+// MarshalJSON implements json.Marshaler.
+func (c Classification) MarshalJSON() ([]byte, error) { return marshalObject(&c) }
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (c *ClassifiedDocument) UnmarshalJSON(data []byte) error { return unmarshalObject(data, c) }
+
+// MarshalJSON implements json.Marshaler.
+func (c ClassifiedDocument) MarshalJSON() ([]byte, error) { return marshalObject(&c) }
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (d *DocumentBatch) UnmarshalJSON(data []byte) error { return unmarshalObject(data, d) }
+
+// MarshalJSON implements json.Marshaler.
+func (d DocumentBatch) MarshalJSON() ([]byte, error) { return marshalObject(&d) }
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (b *BatchDocument) UnmarshalJSON(data []byte) error { return unmarshalObject(data, b) }
+
+// MarshalJSON implements json.Marshaler.
+func (b BatchDocument) MarshalJSON() ([]byte, error) { return marshalObject(&b) }
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (p *PageRange) UnmarshalJSON(data []byte) error { return unmarshalObject(data, p) }
+
+// MarshalJSON implements json.Marshaler.
+func (p PageRange) MarshalJSON() ([]byte, error) { return marshalObject(&p) }
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (w *W2Form) UnmarshalJSON(data []byte) error { return unmarshalObject(data, w) }
+
+// MarshalJSON implements json.Marshaler.
+func (w W2Form) MarshalJSON() ([]byte, error) { return marshalObject(&w) }
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (w *W2Box12) UnmarshalJSON(data []byte) error { return unmarshalObject(data, w) }
+
+// MarshalJSON implements json.Marshaler.
+func (w W2Box12) MarshalJSON() ([]byte, error) { return marshalObject(&w) }
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (w *W2Box14) UnmarshalJSON(data []byte) error { return unmarshalObject(data, w) }
+
+// MarshalJSON implements json.Marshaler.
+func (w W2Box14) MarshalJSON() ([]byte, error) { return marshalObject(&w) }
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (w *W2StateLine) UnmarshalJSON(data []byte) error { return unmarshalObject(data, w) }
+
+// MarshalJSON implements json.Marshaler.
+func (w W2StateLine) MarshalJSON() ([]byte, error) { return marshalObject(&w) }
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (w *W9Form) UnmarshalJSON(data []byte) error { return unmarshalObject(data, w) }
+
+// MarshalJSON implements json.Marshaler.
+func (w W9Form) MarshalJSON() ([]byte, error) { return marshalObject(&w) }
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (w *W8Form) UnmarshalJSON(data []byte) error { return unmarshalObject(data, w) }
+
+// MarshalJSON implements json.Marshaler.
+func (w W8Form) MarshalJSON() ([]byte, error) { return marshalObject(&w) }
+
+// objectField is an encoded field of a response type.
+type objectField struct {
+	name      string
+	index     []int
+	typ       reflect.Type
+	omitEmpty bool
+}
+
+// objectCodec describes how a response type is encoded.
+type objectCodec struct {
+	// fields are the encoded fields in declaration order, those of embedded
+	// structs included.
+	fields []objectField
+
+	// byName and byFoldedName index fields by JSON name and by lowercased
+	// JSON name.
+	byName       map[string]*objectField
+	byFoldedName map[string]*objectField
+
+	// extra and raw are the indexes of the Extra and Raw fields, or nil.
+	extra, raw []int
+}
+
+// lookup returns the field that the JSON member name decodes into. Like the
+// decoder, it prefers an exact match and falls back to one regardless of case.
+func (c *objectCodec) lookup(name string) (*objectField, bool) {
+	if f, ok := c.byName[name]; ok {
+		return f, true
+	}
+	f, ok := c.byFoldedName[strings.ToLower(name)]
+	return f, ok
+}
+
+// objectCodecs caches the results of codecOf by type.
+var objectCodecs sync.Map
+
+var (
+	extraType = reflect.TypeOf(map[string]json.RawMessage{})
+	rawType   = reflect.TypeOf(json.RawMessage{})
+)
+
+// codecOf returns the codec of the struct type t.
+func codecOf(t reflect.Type) *objectCodec {
+	if c, ok := objectCodecs.Load(t); ok {
+		return c.(*objectCodec)
+	}
+
+	c := &objectCodec{byName: map[string]*objectField{}, byFoldedName: map[string]*objectField{}}
+	c.add(t, nil)
+	for i := range c.fields {
+		f := &c.fields[i]
+		c.byName[f.name] = f
+		if _, ok := c.byFoldedName[strings.ToLower(f.name)]; !ok {
+			c.byFoldedName[strings.ToLower(f.name)] = f
+		}
+	}
+	objectCodecs.Store(t, c)
+
+	return c
+}
+
+// add adds the fields of the struct type t, found at index in the response
+// type, to c.
+func (c *objectCodec) add(t reflect.Type, index []int) {
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		fieldIndex := append(append([]int(nil), index...), i)
+		if f.Anonymous && f.Type.Kind() == reflect.Struct && f.Tag.Get("json") == "" {
+			c.add(f.Type, fieldIndex)
+			continue
+		}
+		if f.Tag.Get("json") == "-" && f.Name == "Extra" && f.Type == extraType {
+			c.extra = fieldIndex
+			continue
+		}
+		if f.Tag.Get("json") == "-" && f.Name == "Raw" && f.Type == rawType {
+			c.raw = fieldIndex
+			continue
+		}
+
+		name := jsonName(f)
+		if name == "" {
+			continue
+		}
+		_, options, _ := strings.Cut(f.Tag.Get("json"), ",")
+		c.fields = append(c.fields, objectField{
+			name:      name,
+			index:     fieldIndex,
+			typ:       f.Type,
+			omitEmpty: strings.Contains(","+options+",", ",omitempty,"),
+		})
+	}
+}
+
+// unmarshalObject decodes the JSON object data into v, a pointer to a
+// response type, member by member, and sets its Extra field to the members
+// that v does not declare. Its Raw field, if any, is set to a copy of data.
+// Values of the wrong type are skipped, as json.Unmarshal does, and the first
+// of them is returned once the rest of data is decoded.
+func unmarshalObject(data []byte, v any) error {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		return nil
+	}
+
+	rv := reflect.ValueOf(v).Elem()
+	c := codecOf(rv.Type())
+	dec := json.NewDecoder(bytes.NewReader(data))
+	if tok, err := dec.Token(); err != nil {
+		return err
+	} else if tok != json.Delim('{') {
+		return &json.UnmarshalTypeError{Value: jsonType(data), Type: rv.Type(), Offset: dec.InputOffset()}
+	}
+
+	var extra map[string]json.RawMessage
+	var typeErr *json.UnmarshalTypeError
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		name := tok.(string)
+		var value json.RawMessage
+		if err := dec.Decode(&value); err != nil {
+			return err
+		}
+
+		f, ok := c.lookup(name)
+		if !ok {
+			if extra == nil {
+				extra = map[string]json.RawMessage{}
+			}
+			extra[name] = value
+			continue
+		}
+		err = json.Unmarshal(value, rv.FieldByIndex(f.index).Addr().Interface())
+		var fieldErr *json.UnmarshalTypeError
+		switch {
+		case err == nil:
+		case !errors.As(err, &fieldErr):
+			return err
+		case typeErr == nil:
+			// Report the path of the value from v, as json.Unmarshal does.
+			// Some decoders leave a trailing dot on the path of errors
+			// returned by nested UnmarshalJSON methods.
+			typeErr = fieldErr
+			typeErr.Struct = rv.Type().Name()
+			typeErr.Field = joinPath(f.name, strings.TrimSuffix(fieldErr.Field, "."))
+		}
+	}
+
+	if c.extra != nil {
+		rv.FieldByIndex(c.extra).Set(reflect.ValueOf(extra))
+	}
+	if c.raw != nil {
+		rv.FieldByIndex(c.raw).Set(reflect.ValueOf(append(json.RawMessage(nil), data...)))
+	}
+	if typeErr != nil {
+		return typeErr
+	}
+
+	return nil
+}
+
+// jsonType returns the type of the JSON value data.
+func jsonType(data json.RawMessage) string {
+	switch data[0] {
+	case '{':
+		return "object"
+	case '[':
+		return "array"
+	case '"':
+		return "string"
+	case 't', 'f':
+		return "boolean"
+	case 'n':
+		return "null"
+	default:
+		return "number"
+	}
+}
+
+// marshalObject encodes v, a pointer to a response type, declared fields
+// first, followed by the members of its Extra field that v does not declare,
+// sorted by name.
+func marshalObject(v any) ([]byte, error) {
+	rv := reflect.ValueOf(v).Elem()
+	c := codecOf(rv.Type())
+
+	buf := bytes.NewBufferString("{")
+	write := func(name string, value []byte) error {
+		key, err := json.Marshal(name)
+		if err != nil {
+			return err
+		}
+		if buf.Len() > 1 {
+			buf.WriteByte(',')
+		}
+		buf.Write(key)
+		buf.WriteByte(':')
+		buf.Write(value)
+		return nil
+	}
+
+	for _, f := range c.fields {
+		fv := rv.FieldByIndex(f.index)
+		if f.omitEmpty && isEmptyValue(fv) {
+			continue
+		}
+		value, err := json.Marshal(fv.Addr().Interface())
+		if err != nil {
+			return nil, err
+		}
+		if err := write(f.name, value); err != nil {
+			return nil, err
+		}
+	}
+
+	if c.extra != nil {
+		extra := rv.FieldByIndex(c.extra).Interface().(map[string]json.RawMessage)
+		names := make([]string, 0, len(extra))
+		for name := range extra {
+			if _, ok := c.byFoldedName[strings.ToLower(name)]; !ok {
+				names = append(names, name)
+			}
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			if err := write(name, extra[name]); err != nil {
+				return nil, err
+			}
+		}
+	}
+	buf.WriteByte('}')
+
+	return buf.Bytes(), nil
+}
+
+// isEmptyValue reports whether v is empty as the omitempty option means it.
+func isEmptyValue(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
+		return v.Len() == 0
+	case reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64,
+		reflect.Interface, reflect.Pointer:
+		return v.IsZero()
+	}
+
+	return false
+}
@@ -0,0 +1,84 @@
+package scheme
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitDocument_Extra(t *testing.T) {
+	data := []byte(`{
+		"id": 1,
+		"Total": 10.5,
+		"carbon_footprint": {"kg":1.2},
+		"vendor": {"name": "Walgreens", "loyalty_tier": "gold"},
+		"line_items": [{"description": "Tea", "allergens": ["none"]}]
+	}`)
+
+	doc := Document{}
+	assert.NoError(t, json.Unmarshal(data, &doc))
+	assert.Equal(t, MustParseAmount("10.5"), doc.Total)
+	assert.Equal(t, map[string]json.RawMessage{"carbon_footprint": json.RawMessage(`{"kg":1.2}`)}, doc.Extra)
+	assert.Equal(t, map[string]json.RawMessage{"loyalty_tier": json.RawMessage(`"gold"`)}, doc.Vendor.Extra)
+	assert.Equal(t, map[string]json.RawMessage{"allergens": json.RawMessage(`["none"]`)}, doc.LineItems[0].Extra)
+	assert.Nil(t, doc.BillTo.Extra)
+	assert.JSONEq(t, string(data), string(doc.Raw))
+
+	// Unknown fields are encoded back, and survive another round trip.
+	b, err := json.Marshal(doc)
+	assert.NoError(t, err)
+	fields := map[string]any{}
+	assert.NoError(t, json.Unmarshal(b, &fields))
+	assert.Equal(t, map[string]any{"kg": 1.2}, fields["carbon_footprint"])
+	assert.Equal(t, "gold", fields["vendor"].(map[string]any)["loyalty_tier"])
+
+	again := Document{}
+	assert.NoError(t, json.Unmarshal(b, &again))
+	assert.Equal(t, doc.Extra, again.Extra)
+	assert.Equal(t, doc.Vendor.Extra, again.Vendor.Extra)
+	assert.Equal(t, doc.LineItems[0].Extra, again.LineItems[0].Extra)
+
+	// Declared fields win over Extra entries with the same name.
+	doc.Extra["id"] = json.RawMessage(`2`)
+	b, err = json.Marshal(doc)
+	assert.NoError(t, err)
+	assert.NoError(t, json.Unmarshal(b, &again))
+	assert.Equal(t, 1, again.ID)
+}
+
+func TestUnitDetailedDocument_Extra(t *testing.T) {
+	doc := loadDetailedReceipt(t)
+
+	assert.Contains(t, doc.Extra, "document_type")
+	assert.Contains(t, doc.Vendor.Extra, "logo_name")
+	assert.Contains(t, doc.LineItemsWithScores[0].Extra, "custom_fields")
+	assert.NotEmpty(t, doc.Raw)
+}
+
+func TestUnitW2Form_Extra(t *testing.T) {
+	// Fields of the embedded TaxFormMeta are declared too.
+	form := W2Form{}
+	assert.NoError(t, json.Unmarshal([]byte(`{"id": 7, "tax_year": "2023", "box_12a": "D"}`), &form))
+	assert.Equal(t, 7, form.ID)
+	assert.Equal(t, map[string]json.RawMessage{"box_12a": json.RawMessage(`"D"`)}, form.Extra)
+
+	b, err := json.Marshal(form)
+	assert.NoError(t, err)
+	fields := map[string]any{}
+	assert.NoError(t, json.Unmarshal(b, &fields))
+	assert.Equal(t, 7.0, fields["id"])
+	assert.Equal(t, "D", fields["box_12a"])
+}
+
+func TestUnitDocument_ExtraWithTypeErrors(t *testing.T) {
+	// A value of the wrong type fails decoding, but the rest of the document,
+	// Extra included, is decoded.
+	doc := Document{}
+	err := json.Unmarshal([]byte(`{"id": 1, "vendor": {"name": 5, "loyalty_tier": "gold"}, "guest_count": 3, "zone": "A"}`), &doc)
+	assert.EqualError(t, err, "json: cannot unmarshal number into Go struct field Document.vendor.name of type string")
+	assert.Equal(t, 1, doc.ID)
+	assert.Contains(t, doc.Extra, "zone")
+	assert.Contains(t, doc.Vendor.Extra, "loyalty_tier")
+	assert.NotEmpty(t, doc.Raw)
+}
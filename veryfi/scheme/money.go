@@ -0,0 +1,72 @@
+package scheme
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// currencyDigits lists the ISO 4217 currencies whose minor unit is not 2
+// decimal places.
+var currencyDigits = map[string]int32{
+	"BHD": 3, "BIF": 0, "CLF": 4, "CLP": 0, "DJF": 0, "GNF": 0, "IQD": 3,
+	"ISK": 0, "JOD": 3, "JPY": 0, "KMF": 0, "KRW": 0, "KWD": 3, "LYD": 3,
+	"OMR": 3, "PYG": 0, "RWF": 0, "TND": 3, "UGX": 0, "UYI": 0, "UYW": 4,
+	"VND": 0, "VUV": 0, "XAF": 0, "XOF": 0, "XPF": 0,
+}
+
+// CurrencyDigits returns the number of decimal places of the minor unit of an
+// ISO 4217 currency, e.g. 2 for "USD" and 0 for "JPY". Unknown currencies
+// have 2.
+func CurrencyDigits(currencyCode string) int32 {
+	if digits, ok := currencyDigits[strings.ToUpper(currencyCode)]; ok {
+		return digits
+	}
+
+	return 2
+}
+
+// Money is an amount in a currency.
+type Money struct {
+	Amount   Amount
+	Currency string
+}
+
+// Money returns an amount of the document, such as doc.Total, in the
+// document's currency.
+func (d *Document) Money(a Amount) Money {
+	return Money{Amount: a, Currency: d.CurrencyCode}
+}
+
+// Round returns m rounded to the minor unit of its currency.
+func (m Money) Round() Money {
+	return Money{Amount: m.Amount.Round(CurrencyDigits(m.Currency)), Currency: m.Currency}
+}
+
+// Add returns m + o. It fails if the currencies differ.
+func (m Money) Add(o Money) (Money, error) {
+	if !strings.EqualFold(m.Currency, o.Currency) {
+		return Money{}, errors.Errorf("can not add %s to %s", o.Currency, m.Currency)
+	}
+
+	return Money{Amount: m.Amount.Add(o.Amount), Currency: m.Currency}, nil
+}
+
+// Sub returns m - o. It fails if the currencies differ.
+func (m Money) Sub(o Money) (Money, error) {
+	if !strings.EqualFold(m.Currency, o.Currency) {
+		return Money{}, errors.Errorf("can not subtract %s from %s", o.Currency, m.Currency)
+	}
+
+	return Money{Amount: m.Amount.Sub(o.Amount), Currency: m.Currency}, nil
+}
+
+// String returns m with the precision of its currency, e.g. "29.50 USD".
+func (m Money) String() string {
+	s := m.Amount.StringFixed(max(CurrencyDigits(m.Currency), m.Amount.Scale()))
+	if m.Currency == "" {
+		return s
+	}
+
+	return s + " " + m.Currency
+}
@@ -0,0 +1,63 @@
+package scheme
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// jsonNames returns the JSON names of the encoded fields of the struct t.
+func jsonNames(t reflect.Type) map[string]bool {
+	names := map[string]bool{}
+	for i := 0; i < t.NumField(); i++ {
+		if name := jsonName(t.Field(i)); name != "" {
+			names[name] = true
+		}
+	}
+
+	return names
+}
+
+// missing returns the names of a that are not in b, sorted.
+func missing(a, b map[string]bool) []string {
+	var names []string
+	for name := range a {
+		if !b[name] {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+func TestUnitDetailedParity(t *testing.T) {
+	for _, pair := range []struct {
+		plain, detailed any
+	}{
+		{Document{}, DetailedDocument{}},
+		{Vendor{}, DetailedVendor{}},
+		{ToField{}, DetailedToField{}},
+		{PaymentsInfo{}, DetailedPayment{}},
+		{LineItem{}, DetailedLineItem{}},
+		{TaxLine{}, DetailedTaxLine{}},
+	} {
+		plainType := reflect.TypeOf(pair.plain)
+		detailedType := reflect.TypeOf(pair.detailed)
+		plain := jsonNames(plainType)
+		detailed := jsonNames(detailedType)
+
+		// The scored forms of line items and tax lines are the only fields
+		// the detailed response has on top of the plain one.
+		for name, plainName := range plainNames {
+			if detailed[name] && plain[plainName] {
+				plain[name] = true
+			}
+		}
+
+		assert.Empty(t, missing(plain, detailed), "fields of %s missing from %s", plainType.Name(), detailedType.Name())
+		assert.Empty(t, missing(detailed, plain), "fields of %s missing from %s", detailedType.Name(), plainType.Name())
+	}
+}
@@ -0,0 +1,176 @@
+package scheme
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/creasty/defaults"
+	"github.com/pkg/errors"
+)
+
+// ReviewRule names why a field needs review.
+type ReviewRule string
+
+const (
+	// MissingField is a required field without a value.
+	MissingField ReviewRule = "missing"
+
+	// LowScore is a field whose Score is below its threshold.
+	LowScore ReviewRule = "low_score"
+
+	// LowOCRScore is a field whose OCRScore is below ReviewRules.MinOCRScore.
+	LowOCRScore ReviewRule = "low_ocr_score"
+)
+
+// ReviewRules describes when a detailed document needs human review. Paths
+// are JSON paths as reported by WalkDetailed, in which "[*]" matches any
+// index, e.g. "line_items_with_scores[*].total".
+type ReviewRules struct {
+	// MinScore is the lowest acceptable Score of a field. It defaults to 0.8
+	// when nil; point it to 0 to only check Thresholds.
+	MinScore *float64
+
+	// MinOCRScore is the lowest acceptable OCRScore of a field. It defaults
+	// to 0.7 when nil; point it to 0 to turn the OCR check off.
+	MinOCRScore *float64
+
+	// Thresholds overrides MinScore for some paths, e.g. {"total": 0.95}.
+	Thresholds map[string]float64
+
+	// Required lists the paths that must have a value. Set it to an empty,
+	// non-nil slice to require none.
+	Required []string `default:"[\"date\", \"total\", \"vendor.name\"]"`
+
+	// Ignore lists paths that are never flagged for their scores.
+	Ignore []string
+}
+
+// Default score thresholds of ReviewRules.
+const (
+	defaultMinScore    = 0.8
+	defaultMinOCRScore = 0.7
+)
+
+// ReviewReason is a field that made a document need review.
+type ReviewReason struct {
+	Rule    ReviewRule  `json:"rule"`
+	Field   ScoredField `json:"field"`
+	Message string      `json:"message"`
+}
+
+// Review is the verdict of ReviewDocument on a document.
+type Review struct {
+	DocumentID  int            `json:"document_id"`
+	NeedsReview bool           `json:"needs_review"`
+	Reasons     []ReviewReason `json:"reasons,omitempty"`
+
+	// MinScore is the lowest Score of the checked fields, or 1 if none is
+	// scored.
+	MinScore float64 `json:"min_score"`
+}
+
+// ReviewDocument classifies doc as needing human review or not according to
+// rules. Only fields with a value are checked against the score thresholds,
+// since a low score on an absent field is no value to correct.
+func ReviewDocument(doc *DetailedDocument, rules ReviewRules) (*Review, error) {
+	if err := defaults.Set(&rules); err != nil {
+		return nil, errors.Wrap(err, "fail to set default review rules")
+	}
+	minOCRScore := defaultMinOCRScore
+	if rules.MinOCRScore != nil {
+		minOCRScore = *rules.MinOCRScore
+	}
+
+	review := &Review{DocumentID: doc.ID, MinScore: 1}
+	present := map[string]bool{}
+	WalkDetailed(doc, func(f ScoredField) bool {
+		if f.Value != nil {
+			present[f.Path] = true
+		}
+		if f.Value == nil || matchAny(rules.Ignore, f.Path) {
+			return true
+		}
+		if f.Score != nil {
+			review.MinScore = min(review.MinScore, *f.Score)
+		}
+
+		if threshold := rules.threshold(f.Path); f.Score != nil && *f.Score < threshold {
+			review.flag(LowScore, f, fmt.Sprintf("%s has score %g, below %g", f.Path, *f.Score, threshold))
+		}
+		if f.OCRScore != nil && *f.OCRScore < minOCRScore {
+			review.flag(LowOCRScore, f, fmt.Sprintf("%s has OCR score %g, below %g", f.Path, *f.OCRScore, minOCRScore))
+		}
+		return true
+	})
+
+	for _, path := range rules.Required {
+		if !present[path] {
+			review.flag(MissingField, ScoredField{Path: path}, path+" is missing")
+		}
+	}
+
+	return review, nil
+}
+
+// ReviewQueue returns the reviews of the docs that need review, least
+// confident first.
+func ReviewQueue(docs []DetailedDocument, rules ReviewRules) ([]Review, error) {
+	var queue []Review
+	for i := range docs {
+		review, err := ReviewDocument(&docs[i], rules)
+		if err != nil {
+			return nil, err
+		}
+		if review.NeedsReview {
+			queue = append(queue, *review)
+		}
+	}
+
+	sort.SliceStable(queue, func(i, j int) bool {
+		return queue[i].MinScore < queue[j].MinScore
+	})
+	return queue, nil
+}
+
+// flag records a reason for review.
+func (r *Review) flag(rule ReviewRule, f ScoredField, message string) {
+	r.NeedsReview = true
+	r.Reasons = append(r.Reasons, ReviewReason{Rule: rule, Field: f, Message: message})
+}
+
+// threshold returns the minimum Score of the field at path.
+func (r *ReviewRules) threshold(path string) float64 {
+	if v, ok := r.Thresholds[path]; ok {
+		return v
+	}
+	if v, ok := r.Thresholds[wildcardPath(path)]; ok {
+		return v
+	}
+	if r.MinScore != nil {
+		return *r.MinScore
+	}
+
+	return defaultMinScore
+}
+
+// indexPattern matches the indexes of a JSON path.
+var indexPattern = regexp.MustCompile(`\[\d+\]`)
+
+// wildcardPath replaces the indexes of path by "[*]".
+func wildcardPath(path string) string {
+	return indexPattern.ReplaceAllString(path, "[*]")
+}
+
+// matchAny reports whether path is one of patterns, or matches one with
+// indexes replaced by "[*]".
+func matchAny(patterns []string, path string) bool {
+	wildcard := wildcardPath(path)
+	for _, p := range patterns {
+		if p == path || p == wildcard {
+			return true
+		}
+	}
+
+	return false
+}
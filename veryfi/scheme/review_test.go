@@ -0,0 +1,138 @@
+package scheme
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// loadDetailedReceipt returns the detailed receipt of the client tests.
+func loadDetailedReceipt(t *testing.T) *DetailedDocument {
+	t.Helper()
+
+	b, err := os.ReadFile("../testdata/detailed_receipt_public.json")
+	assert.NoError(t, err)
+	doc := &DetailedDocument{}
+	assert.NoError(t, json.Unmarshal(b, doc))
+	return doc
+}
+
+func TestUnitWalkDetailed(t *testing.T) {
+	doc := loadDetailedReceipt(t)
+
+	fields := map[string]ScoredField{}
+	WalkDetailed(doc, func(f ScoredField) bool {
+		fields[f.Path] = f
+		return true
+	})
+
+	total := fields["total"]
+	assert.Equal(t, 29.53, total.Value)
+	assert.Equal(t, 1.0, *total.Score)
+	assert.Equal(t, 0.99, *total.OCRScore)
+	assert.Len(t, total.BoundingBox, 5)
+
+	assert.Equal(t, "Walgreens", fields["vendor.name"].Value)
+	assert.Equal(t, "2022-05-24 13:10:00", fields["date"].Value)
+	assert.Equal(t, "visa", fields["payment.type"].Value)
+	assert.Equal(t, 0.3, fields["line_items_with_scores[1].total"].Value)
+	assert.Equal(t, 9.625, fields["tax_lines_with_scores[0].rate"].Value)
+	assert.Nil(t, fields["bill_to.name"].Value)
+	assert.NotContains(t, fields, "vendor.parsed_address")
+
+	// Walking stops when fn returns false, and nested types can be walked alone.
+	var paths []string
+	WalkDetailed(&doc.LineItemsWithScores[0], func(f ScoredField) bool {
+		paths = append(paths, f.Path)
+		return len(paths) < 3
+	})
+	assert.Equal(t, []string{"category", "country_of_origin", "date"}, paths)
+	assert.Len(t, DetailedFields(doc.Vendor), 17)
+}
+
+func TestUnitReviewDocument(t *testing.T) {
+	doc := loadDetailedReceipt(t)
+
+	review, err := ReviewDocument(doc, ReviewRules{})
+	assert.NoError(t, err)
+	assert.True(t, review.NeedsReview)
+	assert.Equal(t, 0.59, review.MinScore)
+	assert.Len(t, review.Reasons, 5)
+	assert.Equal(t, "default_category", review.Reasons[0].Field.Path)
+	assert.Equal(t, LowScore, review.Reasons[1].Rule)
+	assert.Equal(t, "line_items_with_scores[0].description", review.Reasons[1].Field.Path)
+	assert.Equal(t, "line_items_with_scores[0].description has score 0.78, below 0.8", review.Reasons[1].Message)
+
+	// Line item descriptions can be ignored, or given their own threshold.
+	review, err = ReviewDocument(doc, ReviewRules{Ignore: []string{
+		"default_category",
+		"line_items_with_scores[*].description",
+		"line_items_with_scores[*].full_description",
+	}})
+	assert.NoError(t, err)
+	assert.False(t, review.NeedsReview)
+
+	review, err = ReviewDocument(doc, ReviewRules{Thresholds: map[string]float64{
+		"default_category":                           0.5,
+		"line_items_with_scores[*].description":      0.5,
+		"line_items_with_scores[*].full_description": 0.5,
+		"total": 1.01,
+	}})
+	assert.NoError(t, err)
+	assert.Len(t, review.Reasons, 1)
+	assert.Equal(t, "total", review.Reasons[0].Field.Path)
+
+	// OCR scores have their own threshold.
+	lenient, strict := 0.5, 0.9
+	review, err = ReviewDocument(doc, ReviewRules{MinScore: &lenient, MinOCRScore: &strict})
+	assert.NoError(t, err)
+	assert.Len(t, review.Reasons, 2)
+	assert.Equal(t, LowOCRScore, review.Reasons[0].Rule)
+	assert.Equal(t, "tax_lines_with_scores[0].rate", review.Reasons[0].Field.Path)
+
+	// Zero thresholds turn the score checks off.
+	off := 0.0
+	review, err = ReviewDocument(doc, ReviewRules{MinScore: &off, MinOCRScore: &off})
+	assert.NoError(t, err)
+	assert.False(t, review.NeedsReview)
+
+	// Required fields must have a value.
+	doc.Total.Value = nil
+	review, err = ReviewDocument(doc, ReviewRules{MinScore: &lenient, Required: []string{"total", "due_date"}})
+	assert.NoError(t, err)
+	assert.Equal(t, []ReviewReason{
+		{Rule: MissingField, Field: ScoredField{Path: "total"}, Message: "total is missing"},
+		{Rule: MissingField, Field: ScoredField{Path: "due_date"}, Message: "due_date is missing"},
+	}, review.Reasons)
+}
+
+func TestUnitReviewQueue(t *testing.T) {
+	first := *loadDetailedReceipt(t)
+	first.ID = 1
+	second := *loadDetailedReceipt(t)
+	second.ID = 2
+	low := 0.5
+	second.Total.Score = &low
+	third := *loadDetailedReceipt(t)
+	third.ID = 3
+	third.Vendor.Name.Score = nil
+
+	queue, err := ReviewQueue([]DetailedDocument{first, second, third}, ReviewRules{
+		Ignore: []string{
+			"default_category",
+			"line_items_with_scores[*].description",
+			"line_items_with_scores[*].full_description",
+		},
+		Thresholds: map[string]float64{
+			"vendor.name": 0.99,
+		},
+	})
+	assert.NoError(t, err)
+	assert.Len(t, queue, 2)
+	assert.Equal(t, 2, queue[0].DocumentID)
+	assert.Equal(t, 0.5, queue[0].MinScore)
+	assert.Equal(t, 1, queue[1].DocumentID)
+	assert.Equal(t, 0.8, queue[1].MinScore)
+}
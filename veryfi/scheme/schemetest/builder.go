@@ -0,0 +1,153 @@
+// Package schemetest provides builders and a seeded generator of realistic
+// scheme values for use in tests.
+package schemetest
+
+import (
+	"math"
+
+	"github.com/veryfi/veryfi-go/v4/veryfi/scheme"
+)
+
+// DocumentBuilder builds a scheme.Document step by step. The zero value is not
+// usable; create one with NewDocument.
+type DocumentBuilder struct {
+	doc scheme.Document
+}
+
+// NewDocument returns a builder for a processed USD document with the given ID.
+func NewDocument(id int) *DocumentBuilder {
+	return &DocumentBuilder{
+		doc: scheme.Document{
+			ID:           id,
+			CurrencyCode: "USD",
+			CountryCode:  "US",
+			Status:       scheme.Processed,
+			IsDocument:   true,
+			Tags:         []scheme.Tag{},
+		},
+	}
+}
+
+// WithCurrency sets the currency code.
+func (b *DocumentBuilder) WithCurrency(currencyCode string) *DocumentBuilder {
+	b.doc.CurrencyCode = currencyCode
+	return b
+}
+
+// WithDate sets the document date in the "2006-01-02 15:04:05" layout.
+func (b *DocumentBuilder) WithDate(date string) *DocumentBuilder {
+	b.doc.Date = date
+	return b
+}
+
+// WithDueDate sets the due date in the "2006-01-02" layout.
+func (b *DocumentBuilder) WithDueDate(dueDate string) *DocumentBuilder {
+	b.doc.DueDate = dueDate
+	return b
+}
+
+// WithInvoiceNumber sets the invoice number.
+func (b *DocumentBuilder) WithInvoiceNumber(invoiceNumber string) *DocumentBuilder {
+	b.doc.InvoiceNumber = invoiceNumber
+	return b
+}
+
+// WithVendor sets the vendor.
+func (b *DocumentBuilder) WithVendor(vendor scheme.Vendor) *DocumentBuilder {
+	b.doc.Vendor = vendor
+	return b
+}
+
+// WithBillTo sets the bill-to party.
+func (b *DocumentBuilder) WithBillTo(billTo scheme.ToField) *DocumentBuilder {
+	b.doc.BillTo = billTo
+	return b
+}
+
+// WithShipTo sets the ship-to party.
+func (b *DocumentBuilder) WithShipTo(shipTo scheme.ToField) *DocumentBuilder {
+	b.doc.ShipTo = shipTo
+	return b
+}
+
+// WithPayment sets the payment information.
+func (b *DocumentBuilder) WithPayment(payment scheme.PaymentsInfo) *DocumentBuilder {
+	b.doc.Payment = payment
+	return b
+}
+
+// WithLineItem appends a line item. Its Order is set to its position.
+func (b *DocumentBuilder) WithLineItem(item scheme.LineItem) *DocumentBuilder {
+	item.Order = len(b.doc.LineItems)
+	b.doc.LineItems = append(b.doc.LineItems, item)
+	return b
+}
+
+// WithTaxLine appends a tax line. Its Order is set to its position.
+func (b *DocumentBuilder) WithTaxLine(line scheme.TaxLine) *DocumentBuilder {
+	line.Order = len(b.doc.TaxLines)
+	b.doc.TaxLines = append(b.doc.TaxLines, line)
+	return b
+}
+
+// WithTip sets the tip.
+func (b *DocumentBuilder) WithTip(tip scheme.Amount) *DocumentBuilder {
+	b.doc.Tip = tip
+	return b
+}
+
+// WithTags sets the tags.
+func (b *DocumentBuilder) WithTags(tags ...scheme.Tag) *DocumentBuilder {
+	b.doc.Tags = tags
+	return b
+}
+
+// Build returns the document with Subtotal, Tax and Total derived from the
+// line items, tax lines and tip so that the amounts are internally consistent.
+func (b *DocumentBuilder) Build() scheme.Document {
+	doc := b.doc
+
+	doc.Subtotal = scheme.Amount{}
+	for _, item := range doc.LineItems {
+		doc.Subtotal = doc.Subtotal.Add(item.Total)
+	}
+	doc.Tax = scheme.Amount{}
+	for _, line := range doc.TaxLines {
+		doc.Tax = doc.Tax.Add(line.Total)
+	}
+	doc.Total = scheme.SumAmounts(doc.Subtotal, doc.Tax, doc.Tip)
+
+	doc.LineItems = append([]scheme.LineItem(nil), doc.LineItems...)
+	doc.TaxLines = append([]scheme.TaxLine(nil), doc.TaxLines...)
+	return doc
+}
+
+// LineItem returns a line item whose Total is quantity times price, rounded to cents.
+func LineItem(description string, quantity float64, price scheme.Amount) scheme.LineItem {
+	return scheme.LineItem{
+		Description: description,
+		Quantity:    quantity,
+		Price:       price,
+		Total:       price.Mul(scheme.MustAmountFromFloat(quantity)).Round(2),
+		Type:        "product",
+		Tags:        []string{},
+	}
+}
+
+// TaxLine returns a tax line whose Total is rate percent of base, rounded to cents.
+func TaxLine(name string, rate float64, base scheme.Amount) scheme.TaxLine {
+	base = base.Round(2)
+	total := base.Mul(scheme.MustAmountFromFloat(rate)).Div(scheme.AmountFromInt(100), 2)
+	return scheme.TaxLine{
+		Name:           name,
+		Rate:           rate,
+		Base:           base,
+		Total:          total,
+		TotalInclusive: base.Add(total),
+	}
+}
+
+// round2 rounds an amount to cents.
+func round2(v float64) float64 {
+	return math.Round(v*100) / 100
+}
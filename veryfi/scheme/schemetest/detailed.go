@@ -0,0 +1,100 @@
+package schemetest
+
+import (
+	"math"
+	"regexp"
+
+	"github.com/veryfi/veryfi-go/v4/veryfi/scheme"
+)
+
+// placer hands out non-overlapping bounding boxes from the top of the page
+// down, so that generated geometry follows the reading order of a receipt.
+type placer struct {
+	g *Generator
+	y float64
+}
+
+// next returns a bounding box ([page, x0, y0, x1, y1]) and the matching
+// bounding region (four corners, clockwise from top-left) for the next line.
+func (p *placer) next() ([]float64, []float64) {
+	x0 := round4(0.05 + p.g.rnd.Float64()*0.4)
+	x1 := round4(x0 + 0.15 + p.g.rnd.Float64()*0.35)
+	y0 := round4(p.y)
+	y1 := round4(p.y + 0.012 + p.g.rnd.Float64()*0.008)
+	p.y = y1 + 0.004
+	if p.y > 0.97 {
+		p.y = 0.03
+	}
+
+	return []float64{0, x0, y0, x1, y1}, []float64{x0, y0, x1, y0, x1, y1, x0, y1}
+}
+
+// Detailed returns the detailed form of doc, as returned with confidence
+// details and bounding boxes enabled. It is built with
+// scheme.NewDetailedDocument, so it holds every field of doc. Populated fields
+// carry a score, an OCR score and geometry, except those the API infers rather
+// than reads, which carry only a score. So do the amounts and dates doc leaves
+// empty, as the API does.
+func (g *Generator) Detailed(doc scheme.Document) scheme.DetailedDocument {
+	p := &placer{g: g, y: 0.03}
+	conf := &scheme.DocumentConfidence{DocumentID: doc.ID, Fields: map[string]scheme.FieldConfidence{}}
+
+	plain := scheme.NewDetailedDocument(&doc, nil)
+	scheme.WalkDetailed(&plain, func(f scheme.ScoredField) bool {
+		c := scheme.FieldConfidence{Score: g.score()}
+		if !inferredFields[indexPattern.ReplaceAllString(f.Path, "[*]")] {
+			c.OCRScore = g.score()
+			c.BoundingBox, c.BoundingRegion = p.next()
+		}
+		conf.Fields[f.Path] = c
+		return true
+	})
+	for _, path := range scoredWhenEmpty {
+		if _, ok := conf.Fields[path]; !ok {
+			conf.Fields[path] = scheme.FieldConfidence{Score: g.score()}
+		}
+	}
+
+	return scheme.NewDetailedDocument(&doc, conf)
+}
+
+// inferredFields are the paths of the fields that the API infers rather than
+// reads from the page, so they have no OCR score or geometry.
+var inferredFields = map[string]bool{
+	"category":                           true,
+	"currency_code":                      true,
+	"payment.type":                       true,
+	"vendor.type":                        true,
+	"line_items_with_scores[*].category": true,
+}
+
+// scoredWhenEmpty are the paths of the fields that the API scores even when
+// it finds no value.
+var scoredWhenEmpty = []string{
+	"date", "due_date", "order_date", "delivery_date", "service_start_date", "service_end_date", "ship_date",
+	"subtotal", "tax", "tip", "discount", "insurance", "rounding", "total",
+}
+
+// indexPattern matches the indexes of a JSON path.
+var indexPattern = regexp.MustCompile(`\[\d+\]`)
+
+// DetailedReceipt returns the detailed form of a freshly generated receipt.
+func (g *Generator) DetailedReceipt() scheme.DetailedDocument {
+	return g.Detailed(g.Receipt())
+}
+
+// DetailedInvoice returns the detailed form of a freshly generated invoice.
+func (g *Generator) DetailedInvoice() scheme.DetailedDocument {
+	return g.Detailed(g.Invoice())
+}
+
+// score returns a plausible confidence score between 0.80 and 1.00.
+func (g *Generator) score() *float64 {
+	v := float64(80+g.rnd.Intn(21)) / 100
+	return &v
+}
+
+// round4 rounds a coordinate to four decimal places, as the API does.
+func round4(v float64) float64 {
+	return math.Round(v*10000) / 10000
+}
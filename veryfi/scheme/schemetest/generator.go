@@ -0,0 +1,334 @@
+package schemetest
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+	"time"
+
+	"github.com/veryfi/veryfi-go/v4/veryfi/scheme"
+)
+
+// vendorProfile describes a plausible vendor and what it sells.
+type vendorProfile struct {
+	name     string
+	category string
+	kind     string
+	web      string
+	products []product
+	tipped   bool
+}
+
+// product is a catalogue entry for a line item.
+type product struct {
+	description string
+	price       float64
+	unit        string
+}
+
+// addressProfile is a plausible US address.
+type addressProfile struct {
+	street   string
+	city     string
+	state    string
+	postcode string
+	phone    string
+	taxRate  float64
+}
+
+var vendorProfiles = []vendorProfile{
+	{
+		name: "Walgreens", category: "Job Supplies", kind: "Pharmacy", web: "walgreens.com",
+		products: []product{
+			{"RED BULL ENRGY DRNK CNS 8.4OZ 6PK", 8.79, ""},
+			{"COCA COLA MINICAN 7.5Z 6PK", 4.99, ""},
+			{"SCOTCH BRITE H/D KITCHN SPONGE 3S", 4.79, ""},
+			{"PALMOLIVE DISH OXI POWER 10OZ", 1.49, ""},
+			{"SHOPPING BAG FEE", 0.25, ""},
+		},
+	},
+	{
+		name: "In-N-Out Burger", category: "Meals & Entertainment", kind: "Restaurant", web: "in-n-out.com",
+		tipped: true,
+		products: []product{
+			{"Double-Double", 5.85, ""},
+			{"Cheeseburger", 3.65, ""},
+			{"French Fries", 2.30, ""},
+			{"Medium Soft Drink", 2.10, ""},
+			{"Chocolate Shake", 3.15, ""},
+		},
+	},
+	{
+		name: "Home Depot", category: "Repairs & Maintenance", kind: "Hardware Store", web: "homedepot.com",
+		products: []product{
+			{"2X4X8 PREMIUM KD WHITEWOOD", 3.98, "ea"},
+			{"DRYWALL SCREWS 1-5/8IN 1LB", 8.47, "lb"},
+			{"BEHR PREMIUM PLUS 1GAL WHITE", 32.98, "gal"},
+			{"PAINTERS TAPE 1.88IN", 7.48, "roll"},
+		},
+	},
+	{
+		name: "Shell", category: "Fuel", kind: "Gas Station", web: "shell.us",
+		products: []product{
+			{"UNLEADED", 4.59, "gal"},
+			{"V-POWER NITRO+ PREMIUM", 5.29, "gal"},
+		},
+	},
+	{
+		name: "East Repair Inc.", category: "Repairs & Maintenance", kind: "Auto Repair", web: "eastrepair.com",
+		products: []product{
+			{"Front and rear brake cables", 100.00, ""},
+			{"New set of pedal arms", 15.00, ""},
+			{"Labor 3hrs", 5.00, "hr"},
+		},
+	},
+	{
+		name: "Acme Consulting LLC", category: "Professional Services", kind: "Consulting", web: "acmeconsulting.com",
+		products: []product{
+			{"Software development services", 150.00, "hr"},
+			{"Project management", 95.00, "hr"},
+			{"Cloud hosting (monthly)", 249.00, ""},
+		},
+	},
+}
+
+var addressProfiles = []addressProfile{
+	{"191 E 3rd Ave", "San Mateo", "CA", "94401", "650-342-2723", 9.625},
+	{"1912 Harvest Lane", "New York", "NY", "12210", "212-555-0142", 8.875},
+	{"4750 W Sahara Ave", "Las Vegas", "NV", "89102", "702-555-0117", 8.375},
+	{"800 Boylston St", "Boston", "MA", "02199", "617-555-0190", 6.25},
+	{"1 Microsoft Way", "Redmond", "WA", "98052", "425-555-0100", 10.1},
+}
+
+var personNames = []string{
+	"John Smith", "Maria Garcia", "Wei Chen", "Aisha Khan", "Liam O'Brien", "Sofia Rossi",
+}
+
+var cardTypes = []struct {
+	kind, name string
+}{
+	{"visa", "Visa"},
+	{"mastercard", "Mastercard"},
+	{"amex", "American Express"},
+	{"cash", "Cash"},
+}
+
+// Generator produces internally consistent fake documents. Two generators
+// created with the same seed produce the same sequence of values. It is not
+// safe for concurrent use.
+type Generator struct {
+	rnd    *rand.Rand
+	nextID int
+	epoch  time.Time
+}
+
+// NewGenerator returns a generator seeded with seed.
+func NewGenerator(seed int64) *Generator {
+	return &Generator{
+		rnd:    rand.New(rand.NewSource(seed)),
+		nextID: 36966934,
+		epoch:  time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC),
+	}
+}
+
+// Vendor returns a plausible vendor.
+func (g *Generator) Vendor() scheme.Vendor {
+	return g.vendor(g.vendorProfile(), g.address())
+}
+
+// ToField returns a plausible bill-to or ship-to party.
+func (g *Generator) ToField() scheme.ToField {
+	a := g.address()
+	name := pick(g, personNames)
+	return scheme.ToField{
+		Name:          name,
+		Address:       fullAddress(a),
+		ParsedAddress: parsedAddress(a),
+		Email:         strings.ToLower(strings.NewReplacer(" ", ".", "'", "").Replace(name)) + "@example.com",
+		PhoneNumber:   a.phone,
+	}
+}
+
+// Receipt returns a point-of-sale receipt whose line items sum to the
+// subtotal, whose tax lines sum to the tax and whose total is subtotal plus
+// tax plus tip.
+func (g *Generator) Receipt() scheme.Document {
+	profile := g.vendorProfile()
+	a := g.address()
+	date := g.date()
+	card := cardTypes[g.rnd.Intn(len(cardTypes))]
+
+	b := NewDocument(g.id()).
+		WithDate(date.Format(scheme.DateTimeLayout)).
+		WithInvoiceNumber(fmt.Sprintf("%d", 1000+g.rnd.Intn(9000))).
+		WithVendor(g.vendor(profile, a)).
+		WithPayment(scheme.PaymentsInfo{
+			CardNumber:  g.cardNumber(card.kind),
+			DisplayName: card.name,
+			Type:        card.kind,
+		})
+	g.addLineItems(b, profile, date)
+
+	doc := b.Build()
+	if profile.tipped {
+		doc.Tip = doc.Subtotal.Mul(scheme.NewAmount(int64(15+g.rnd.Intn(11)), 2)).Round(2)
+	}
+	doc = b.WithTip(doc.Tip).WithTaxLine(TaxLine("Sales Tax", a.taxRate, doc.Subtotal)).Build()
+
+	g.finish(&doc, profile, date)
+	return doc
+}
+
+// Invoice returns a business invoice with bill-to and ship-to parties, a due
+// date and amounts that are consistent in the same way as Receipt.
+func (g *Generator) Invoice() scheme.Document {
+	profile := g.vendorProfile()
+	a := g.address()
+	date := g.date().Truncate(24 * time.Hour)
+	billTo := g.ToField()
+
+	b := NewDocument(g.id()).
+		WithDate(date.Format(scheme.DateTimeLayout)).
+		WithDueDate(date.AddDate(0, 0, 30).Format(scheme.DateLayout)).
+		WithInvoiceNumber(fmt.Sprintf("INV-%05d", g.rnd.Intn(100000))).
+		WithVendor(g.vendor(profile, a)).
+		WithBillTo(billTo).
+		WithShipTo(billTo).
+		WithPayment(scheme.PaymentsInfo{
+			DisplayName: "Net 30",
+			Terms:       "Net 30",
+			Type:        "check",
+		})
+	g.addLineItems(b, profile, date)
+
+	doc := b.Build()
+	doc = b.WithTaxLine(TaxLine("Sales Tax", a.taxRate, doc.Subtotal)).Build()
+	doc.PurchaseOrderNumber = fmt.Sprintf("PO-%04d", g.rnd.Intn(10000))
+	doc.Vendor.BankName = "First National Bank"
+	doc.Vendor.AccountNumber = fmt.Sprintf("%010d", g.rnd.Int63n(1e10))
+	doc.Vendor.BankNumber = fmt.Sprintf("%09d", g.rnd.Int63n(1e9))
+
+	g.finish(&doc, profile, date)
+	return doc
+}
+
+// vendorProfile picks a random vendor profile.
+func (g *Generator) vendorProfile() vendorProfile {
+	return vendorProfiles[g.rnd.Intn(len(vendorProfiles))]
+}
+
+// address picks a random address profile.
+func (g *Generator) address() addressProfile {
+	return addressProfiles[g.rnd.Intn(len(addressProfiles))]
+}
+
+// vendor builds a vendor from a profile located at an address.
+func (g *Generator) vendor(p vendorProfile, a addressProfile) scheme.Vendor {
+	return scheme.Vendor{
+		Name:          p.name,
+		RawName:       p.name,
+		Address:       fullAddress(a),
+		ParsedAddress: parsedAddress(a),
+		PhoneNumber:   a.phone,
+		Web:           p.web,
+		Email:         "billing@" + p.web,
+		Type:          p.kind,
+		Logo:          fmt.Sprintf("https://cdn.veryfi.com/logos/us/%09d.png", g.rnd.Intn(1e9)),
+	}
+}
+
+// addLineItems appends one to five catalogue items from the profile.
+func (g *Generator) addLineItems(b *DocumentBuilder, p vendorProfile, date time.Time) {
+	n := 1 + g.rnd.Intn(5)
+	for i := 0; i < n; i++ {
+		prod := p.products[g.rnd.Intn(len(p.products))]
+		quantity := float64(1 + g.rnd.Intn(3))
+		if prod.unit == "gal" {
+			quantity = round2(5 + g.rnd.Float64()*10)
+		}
+
+		item := LineItem(prod.description, quantity, scheme.MustAmountFromFloat(prod.price))
+		item.ID = g.id()
+		item.UnitOfMeasure = prod.unit
+		item.Date = date.Format(scheme.DateLayout)
+		b.WithLineItem(item)
+	}
+}
+
+// finish fills the bookkeeping fields shared by every generated document.
+func (g *Generator) finish(doc *scheme.Document, p vendorProfile, date time.Time) {
+	created := date.Add(time.Duration(1+g.rnd.Intn(72)) * time.Hour)
+	doc.Category = p.category
+	doc.DefaultCategory = p.category
+	doc.Created = created.Format(scheme.DateTimeLayout)
+	doc.Updated = created.Add(8 * time.Second).Format(scheme.DateTimeLayout)
+	doc.ImgFileName = fmt.Sprintf("%d.jpg", doc.ID)
+	doc.ImgURL = fmt.Sprintf("https://scdn.veryfi.com/receipts/%d.jpg", doc.ID)
+	doc.ImgThumbnailURL = fmt.Sprintf("https://scdn.veryfi.com/receipts/%d_t.jpg", doc.ID)
+	doc.ReferenceNumber = fmt.Sprintf("VF%s-%d", strings.ToUpper(fmt.Sprintf("%03x", g.rnd.Intn(4096))), doc.ID%100000)
+	doc.AccountingEntryType = "debit"
+
+	quantity := 0.0
+	for _, item := range doc.LineItems {
+		quantity += item.Quantity
+	}
+	doc.TotalQuantity = round2(quantity)
+	doc.OCRText = ocrText(doc)
+}
+
+// id returns the next unique ID.
+func (g *Generator) id() int {
+	g.nextID++
+	return g.nextID
+}
+
+// date returns a random time during the generator's year.
+func (g *Generator) date() time.Time {
+	return g.epoch.Add(time.Duration(g.rnd.Int63n(int64(365 * 24 * time.Hour)))).Truncate(time.Minute)
+}
+
+// cardNumber returns the last four digits of a card, or "" for cash.
+func (g *Generator) cardNumber(kind string) string {
+	if kind == "cash" {
+		return ""
+	}
+	return fmt.Sprintf("%04d", g.rnd.Intn(10000))
+}
+
+// pick returns a random element of values.
+func pick(g *Generator, values []string) string {
+	return values[g.rnd.Intn(len(values))]
+}
+
+// fullAddress formats an address on one line.
+func fullAddress(a addressProfile) string {
+	return fmt.Sprintf("%s, %s, %s %s", a.street, a.city, a.state, a.postcode)
+}
+
+// parsedAddress returns the parsed form of an address.
+func parsedAddress(a addressProfile) scheme.ParsedAddress {
+	return scheme.ParsedAddress{
+		StreetAddress: a.street,
+		City:          a.city,
+		State:         a.state,
+		Postcode:      a.postcode,
+		Country:       "US",
+	}
+}
+
+// ocrText renders a plain-text approximation of the document.
+func ocrText(doc *scheme.Document) string {
+	lines := []string{doc.Vendor.Name, doc.Vendor.Address, doc.Vendor.PhoneNumber, doc.Date}
+	for _, item := range doc.LineItems {
+		lines = append(lines, fmt.Sprintf("%s\t%s", item.Description, item.Total.StringFixed(2)))
+	}
+	lines = append(lines, "SUBTOTAL\t"+doc.Subtotal.StringFixed(2))
+	for _, line := range doc.TaxLines {
+		lines = append(lines, fmt.Sprintf("%s %.3f%%\t%s", strings.ToUpper(line.Name), line.Rate, line.Total.StringFixed(2)))
+	}
+	if !doc.Tip.IsZero() {
+		lines = append(lines, "TIP\t"+doc.Tip.StringFixed(2))
+	}
+	lines = append(lines, "TOTAL\t"+doc.Total.StringFixed(2))
+	return strings.Join(lines, "\n")
+}
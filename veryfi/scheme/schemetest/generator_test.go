@@ -0,0 +1,119 @@
+package schemetest
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/veryfi/veryfi-go/v4/veryfi/scheme"
+)
+
+// assertConsistent checks the arithmetic invariants promised by the generator.
+// Line and tax totals are rounded to cents; the sums are exact.
+func assertConsistent(t *testing.T, doc scheme.Document) {
+	t.Helper()
+
+	assert.NotEmpty(t, doc.LineItems)
+
+	var subtotal scheme.Amount
+	for _, item := range doc.LineItems {
+		assert.Equal(t, item.Price.Mul(scheme.MustAmountFromFloat(item.Quantity)).Round(2), item.Total)
+		subtotal = subtotal.Add(item.Total)
+	}
+	assert.Equal(t, subtotal, doc.Subtotal)
+
+	var tax scheme.Amount
+	for _, line := range doc.TaxLines {
+		assert.Equal(t, line.Base.Mul(scheme.MustAmountFromFloat(line.Rate)).Div(scheme.AmountFromInt(100), 2), line.Total)
+		tax = tax.Add(line.Total)
+	}
+	assert.Equal(t, tax, doc.Tax)
+	assert.Equal(t, scheme.SumAmounts(doc.Subtotal, doc.Tax, doc.Tip), doc.Total)
+
+	findings, err := scheme.ValidateDocument(&doc, scheme.ValidationOptions{})
+	assert.NoError(t, err)
+	assert.Empty(t, findings)
+}
+
+func TestUnitGenerator_Consistent(t *testing.T) {
+	g := NewGenerator(42)
+	for i := 0; i < 50; i++ {
+		receipt := g.Receipt()
+		assertConsistent(t, receipt)
+		assert.NotEmpty(t, receipt.Vendor.Name)
+
+		invoice := g.Invoice()
+		assertConsistent(t, invoice)
+		assert.NotEmpty(t, invoice.BillTo.Name)
+		assert.NotEmpty(t, invoice.DueDate)
+	}
+}
+
+func TestUnitGenerator_Deterministic(t *testing.T) {
+	assert.Equal(t, NewGenerator(7).Receipt(), NewGenerator(7).Receipt())
+	assert.NotEqual(t, NewGenerator(7).Receipt(), NewGenerator(8).Receipt())
+}
+
+func TestUnitGenerator_Detailed(t *testing.T) {
+	g := NewGenerator(1)
+	doc := g.Receipt()
+	detailed := g.Detailed(doc)
+
+	assert.Equal(t, doc.ID, detailed.ID)
+	assert.Equal(t, doc.Total.Float64(), *detailed.Total.Value)
+	assert.Equal(t, doc.Vendor.Name, *detailed.Vendor.Name.Value)
+	assert.Len(t, detailed.LineItemsWithScores, len(doc.LineItems))
+	assert.Len(t, detailed.TaxLinesWithScores, len(doc.TaxLines))
+
+	assert.Len(t, detailed.Total.BoundingBox, 5)
+	assert.Len(t, detailed.Total.BoundingRegion, 8)
+	assert.GreaterOrEqual(t, *detailed.Total.Score, 0.8)
+	assert.LessOrEqual(t, *detailed.Total.Score, 1.0)
+
+	// Empty fields carry a score but no value or geometry.
+	assert.Nil(t, detailed.Discount.Value)
+	assert.NotNil(t, detailed.Discount.Score)
+	assert.Nil(t, detailed.Discount.BoundingBox)
+
+	// Inferred fields carry a score but no geometry.
+	assert.Equal(t, doc.CurrencyCode, *detailed.CurrencyCode.Value)
+	assert.NotNil(t, detailed.CurrencyCode.Score)
+	assert.Nil(t, detailed.CurrencyCode.BoundingBox)
+
+	// The detailed form holds every field of the document.
+	for _, doc := range []scheme.Document{doc, g.Invoice()} {
+		detailed := g.Detailed(doc)
+		back, _, err := detailed.ToDocument()
+		assert.NoError(t, err)
+		assert.Equal(t, doc, back)
+	}
+}
+
+func TestUnitDocumentBuilder(t *testing.T) {
+	doc := NewDocument(1).
+		WithLineItem(LineItem("Widget", 2, scheme.MustParseAmount("4.99"))).
+		WithLineItem(LineItem("Gadget", 1, scheme.AmountFromInt(10))).
+		WithTaxLine(TaxLine("Sales Tax", 10, scheme.MustParseAmount("19.98"))).
+		WithTip(scheme.AmountFromInt(3)).
+		Build()
+
+	assert.Equal(t, scheme.MustParseAmount("19.98"), doc.Subtotal)
+	assert.Equal(t, scheme.AmountFromInt(2), doc.Tax)
+	assert.Equal(t, scheme.MustParseAmount("24.98"), doc.Total)
+	assert.Equal(t, 1, doc.LineItems[1].Order)
+	assertConsistent(t, doc)
+}
+
+func TestUnitWithoutUnknown(t *testing.T) {
+	docs := []scheme.Document{}
+	assert.NoError(t, json.Unmarshal([]byte(`[{"id": 1, "zone": "A", "vendor": {"name": "Cafe", "loyalty_tier": "gold"}}]`), &docs))
+	assert.NotNil(t, docs[0].Extra)
+
+	assert.Equal(t, []scheme.Document{{ID: 1, Vendor: scheme.Vendor{Name: "Cafe"}}}, WithoutUnknown(docs))
+	assert.Equal(t, &scheme.Document{ID: 1, Vendor: scheme.Vendor{Name: "Cafe"}}, WithoutUnknown(&docs[0]))
+
+	// The decoded documents are left unchanged.
+	assert.NotNil(t, docs[0].Extra)
+	assert.NotNil(t, docs[0].Raw)
+	assert.NotNil(t, docs[0].Vendor.Extra)
+}
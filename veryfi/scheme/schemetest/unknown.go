@@ -0,0 +1,71 @@
+package schemetest
+
+import (
+	"encoding/json"
+	"reflect"
+)
+
+var (
+	extraType = reflect.TypeOf(map[string]json.RawMessage{})
+	rawType   = reflect.TypeOf(json.RawMessage{})
+)
+
+// WithoutUnknown returns a deep copy of v, a decoded response or a value
+// holding some, with the Extra and Raw fields of every response type cleared,
+// so that it compares equal to a value built in a test. v is left unchanged.
+func WithoutUnknown[T any](v T) T {
+	var out T
+	reflect.ValueOf(&out).Elem().Set(copyWithoutUnknown(reflect.ValueOf(&v).Elem()))
+	return out
+}
+
+// copyWithoutUnknown returns a deep copy of v without the Extra and Raw
+// fields of the values it holds.
+func copyWithoutUnknown(v reflect.Value) reflect.Value {
+	out := reflect.New(v.Type()).Elem()
+	switch v.Kind() {
+	case reflect.Pointer:
+		if !v.IsNil() {
+			out.Set(reflect.New(v.Type().Elem()))
+			out.Elem().Set(copyWithoutUnknown(v.Elem()))
+		}
+	case reflect.Interface:
+		if !v.IsNil() {
+			out.Set(copyWithoutUnknown(v.Elem()))
+		}
+	case reflect.Slice:
+		if !v.IsNil() {
+			out.Set(reflect.MakeSlice(v.Type(), v.Len(), v.Len()))
+			for i := 0; i < v.Len(); i++ {
+				out.Index(i).Set(copyWithoutUnknown(v.Index(i)))
+			}
+		}
+	case reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			out.Index(i).Set(copyWithoutUnknown(v.Index(i)))
+		}
+	case reflect.Map:
+		if !v.IsNil() {
+			out.Set(reflect.MakeMapWithSize(v.Type(), v.Len()))
+			for iter := v.MapRange(); iter.Next(); {
+				out.SetMapIndex(iter.Key(), copyWithoutUnknown(iter.Value()))
+			}
+		}
+	case reflect.Struct:
+		out.Set(v)
+		for i := 0; i < v.NumField(); i++ {
+			f := v.Type().Field(i)
+			switch {
+			case !f.IsExported():
+			case f.Tag.Get("json") == "-" && (f.Name == "Extra" && f.Type == extraType || f.Name == "Raw" && f.Type == rawType):
+				out.Field(i).SetZero()
+			default:
+				out.Field(i).Set(copyWithoutUnknown(v.Field(i)))
+			}
+		}
+	default:
+		out.Set(v)
+	}
+
+	return out
+}
@@ -0,0 +1,308 @@
+package scheme
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"slices"
+)
+
+// TaxFormUploadOptions describes the query parameters to process a tax form file upload.
+type TaxFormUploadOptions struct {
+	FilePath string
+
+	TaxFormSharedOptions
+}
+
+// TaxFormUploadBase64Options describes the query parameters to process a Base64 encoded tax form.
+type TaxFormUploadBase64Options struct {
+	FileData string `json:"file_data,omitempty"`
+
+	TaxFormSharedOptions
+}
+
+// TaxFormURLOptions describes the query parameters to process a tax form using a URL.
+type TaxFormURLOptions struct {
+	FileURL  string   `json:"file_url,omitempty"`
+	FileURLS []string `json:"file_urls,omitempty"`
+
+	TaxFormSharedOptions
+}
+
+// TaxFormSharedOptions describes the shared query parameters among the tax form processing API.
+type TaxFormSharedOptions struct {
+	FileName   string `json:"file_name,omitempty"`
+	ExternalID string `json:"external_id,omitempty"`
+	Async      bool   `json:"async,omitempty"`
+}
+
+// TaxFormSearchOptions describes the query parameters to search tax forms.
+type TaxFormSearchOptions struct {
+	ExternalID string `json:"external_id"`
+	CreatedGT  string `json:"created_date__gt"`
+	CreatedGTE string `json:"created_date__gte"`
+	CreatedLT  string `json:"created_date__lt"`
+	CreatedLTE string `json:"created_date__lte"`
+	Page       string `json:"page"`
+	PageSize   string `json:"page_size"`
+}
+
+// TaxFormMeta holds the fields common to every processed tax form.
+type TaxFormMeta struct {
+	ID              int    `json:"id"`
+	ExternalID      string `json:"external_id"`
+	Created         string `json:"created_date"`
+	Updated         string `json:"updated_date"`
+	ImgFileName     string `json:"img_file_name"`
+	ImgThumbnailURL string `json:"img_thumbnail_url"`
+	ImgURL          string `json:"img_url"`
+	PDFURL          string `json:"pdf_url"`
+	OCRText         string `json:"ocr_text"`
+}
+
+// W2Forms describes a list of W-2 forms.
+type W2Forms struct {
+	W2s  []W2Form      `json:"documents"`
+	Meta DocumentsMeta `json:"meta"`
+}
+
+// W2Form describes the W-2 (Wage and Tax Statement) response. Box fields are
+// named after the box they are printed in.
+type W2Form struct {
+	TaxFormMeta
+
+	TaxYear         string `json:"tax_year"`
+	ControlNumber   string `json:"control_number"`
+	EmployeeSSN     TIN    `json:"employee_ssn"`
+	EmployeeName    string `json:"employee_name"`
+	EmployeeAddress string `json:"employee_address"`
+	EmployerEIN     TIN    `json:"employer_ein"`
+	EmployerName    string `json:"employer_name"`
+	EmployerAddress string `json:"employer_address"`
+
+	Box1WagesTipsOtherCompensation Amount        `json:"wages_tips_other_compensation"`
+	Box2FederalIncomeTaxWithheld   Amount        `json:"federal_income_tax_withheld"`
+	Box3SocialSecurityWages        Amount        `json:"social_security_wages"`
+	Box4SocialSecurityTaxWithheld  Amount        `json:"social_security_tax_withheld"`
+	Box5MedicareWagesAndTips       Amount        `json:"medicare_wages_and_tips"`
+	Box6MedicareTaxWithheld        Amount        `json:"medicare_tax_withheld"`
+	Box7SocialSecurityTips         Amount        `json:"social_security_tips"`
+	Box8AllocatedTips              Amount        `json:"allocated_tips"`
+	Box9VerificationCode           string        `json:"verification_code"`
+	Box10DependentCareBenefits     Amount        `json:"dependent_care_benefits"`
+	Box11NonqualifiedPlans         Amount        `json:"nonqualified_plans"`
+	Box12                          []W2Box12     `json:"box_12"`
+	Box13StatutoryEmployee         bool          `json:"statutory_employee"`
+	Box13RetirementPlan            bool          `json:"retirement_plan"`
+	Box13ThirdPartySickPay         bool          `json:"third_party_sick_pay"`
+	Box14Other                     []W2Box14     `json:"box_14"`
+	States                         []W2StateLine `json:"states"`
+
+	// Extra holds the fields of the response that W2Form does not declare.
+	Extra map[string]json.RawMessage `json:"-"`
+
+	// Raw holds the JSON the document was decoded from.
+	Raw json.RawMessage `json:"-"`
+}
+
+// W2Box12 describes a coded amount of box 12 of a W-2 form.
+type W2Box12 struct {
+	Code   string `json:"code"`
+	Amount Amount `json:"amount"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// W2Box14 describes an item of box 14 (Other) of a W-2 form.
+type W2Box14 struct {
+	Description string `json:"description"`
+	Amount      Amount `json:"amount"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// W2StateLine describes one line of boxes 15 to 20 of a W-2 form, which are
+// repeated for every state and locality.
+type W2StateLine struct {
+	Box15State           string `json:"state"`
+	Box15EmployerStateID string `json:"employer_state_id"`
+	Box16StateWages      Amount `json:"state_wages"`
+	Box17StateIncomeTax  Amount `json:"state_income_tax"`
+	Box18LocalWages      Amount `json:"local_wages"`
+	Box19LocalIncomeTax  Amount `json:"local_income_tax"`
+	Box20LocalityName    string `json:"locality_name"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// W9EntityType describes the federal tax classification checked on a W-9 form.
+type W9EntityType string
+
+const (
+	W9EntityIndividual  W9EntityType = "individual"
+	W9EntityCCorp       W9EntityType = "c_corporation"
+	W9EntitySCorp       W9EntityType = "s_corporation"
+	W9EntityPartnership W9EntityType = "partnership"
+	W9EntityTrustEstate W9EntityType = "trust_estate"
+	W9EntityLLC         W9EntityType = "llc"
+	W9EntityOther       W9EntityType = "other"
+)
+
+// W9Forms describes a list of W-9 forms.
+type W9Forms struct {
+	W9s  []W9Form      `json:"documents"`
+	Meta DocumentsMeta `json:"meta"`
+}
+
+// W9Form describes the W-9 (Request for Taxpayer Identification Number and
+// Certification) response.
+type W9Form struct {
+	TaxFormMeta
+
+	Name                 string       `json:"name"`
+	BusinessName         string       `json:"business_name"`
+	EntityType           W9EntityType `json:"entity_type"`
+	LLCTaxClassification string       `json:"llc_tax_classification"`
+	OtherEntityType      string       `json:"other_entity_type"`
+	ExemptPayeeCode      string       `json:"exempt_payee_code"`
+	FATCAExemptionCode   string       `json:"fatca_exemption_code"`
+	Address              string       `json:"address"`
+	CityStateZip         string       `json:"city_state_zip"`
+	RequesterNameAddress string       `json:"requester_name_and_address"`
+	AccountNumbers       string       `json:"account_numbers"`
+	SSN                  TIN          `json:"ssn"`
+	EIN                  TIN          `json:"ein"`
+	IsSigned             bool         `json:"is_signed"`
+	CertificationDate    string       `json:"certification_date"`
+
+	// Extra holds the fields of the response that W9Form does not declare.
+	Extra map[string]json.RawMessage `json:"-"`
+
+	// Raw holds the JSON the document was decoded from.
+	Raw json.RawMessage `json:"-"`
+}
+
+// W8Forms describes a list of W-8 forms.
+type W8Forms struct {
+	W8s  []W8Form      `json:"documents"`
+	Meta DocumentsMeta `json:"meta"`
+}
+
+// W8Form describes the W-8BEN and W-8BEN-E (Certificate of Foreign Status of
+// Beneficial Owner) response.
+type W8Form struct {
+	TaxFormMeta
+
+	FormType               string `json:"form_type"`
+	Name                   string `json:"name"`
+	CountryOfCitizenship   string `json:"country_of_citizenship"`
+	CountryOfIncorporation string `json:"country_of_incorporation"`
+	EntityType             string `json:"entity_type"`
+	FATCAStatus            string `json:"fatca_status"`
+	PermanentAddress       string `json:"permanent_address"`
+	MailingAddress         string `json:"mailing_address"`
+	USTIN                  TIN    `json:"us_tin"`
+	ForeignTIN             TIN    `json:"foreign_tin"`
+	GIIN                   string `json:"giin"`
+	ReferenceNumber        string `json:"reference_number"`
+	DateOfBirth            string `json:"date_of_birth"`
+	TreatyCountry          string `json:"treaty_country"`
+	SignerName             string `json:"signer_name"`
+	SignerCapacity         string `json:"signer_capacity"`
+	IsSigned               bool   `json:"is_signed"`
+	CertificationDate      string `json:"certification_date"`
+
+	// Extra holds the fields of the response that W8Form does not declare.
+	Extra map[string]json.RawMessage `json:"-"`
+
+	// Raw holds the JSON the document was decoded from.
+	Raw json.RawMessage `json:"-"`
+}
+
+// redactedOCRText replaces the OCR text of a formatted tax form, which holds
+// its TINs in full.
+const redactedOCRText = "[redacted]"
+
+// redacted returns m with its OCR text redacted.
+func (m TaxFormMeta) redacted() TaxFormMeta {
+	if m.OCRText != "" {
+		m.OCRText = redactedOCRText
+	}
+
+	return m
+}
+
+// Format implements fmt.Formatter. TINs are masked, the OCR text is redacted,
+// and Raw and the unknown fields, which may hold TINs in full, are left out.
+func (f W2Form) Format(s fmt.State, verb rune) {
+	type plain W2Form
+	f.TaxFormMeta = f.TaxFormMeta.redacted()
+	f.Extra, f.Raw = nil, nil
+	f.Box12 = slices.Clone(f.Box12)
+	for i := range f.Box12 {
+		f.Box12[i].Extra = nil
+	}
+	f.Box14Other = slices.Clone(f.Box14Other)
+	for i := range f.Box14Other {
+		f.Box14Other[i].Extra = nil
+	}
+	f.States = slices.Clone(f.States)
+	for i := range f.States {
+		f.States[i].Extra = nil
+	}
+	fmt.Fprintf(s, fmt.FormatString(s, verb), plain(f))
+}
+
+// Format implements fmt.Formatter. TINs are masked, the OCR text is redacted,
+// and Raw and the unknown fields, which may hold TINs in full, are left out.
+func (f W9Form) Format(s fmt.State, verb rune) {
+	type plain W9Form
+	f.TaxFormMeta = f.TaxFormMeta.redacted()
+	f.Extra, f.Raw = nil, nil
+	fmt.Fprintf(s, fmt.FormatString(s, verb), plain(f))
+}
+
+// Format implements fmt.Formatter. TINs are masked, the OCR text is redacted,
+// and Raw and the unknown fields, which may hold TINs in full, are left out.
+func (f W8Form) Format(s fmt.State, verb rune) {
+	type plain W8Form
+	f.TaxFormMeta = f.TaxFormMeta.redacted()
+	f.Extra, f.Raw = nil, nil
+	fmt.Fprintf(s, fmt.FormatString(s, verb), plain(f))
+}
+
+// LogValue implements slog.LogValuer. It logs identifying fields only, with
+// TINs masked, since a handler would otherwise encode the full form.
+func (f W2Form) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.Int("id", f.ID),
+		slog.String("external_id", f.ExternalID),
+		slog.String("tax_year", f.TaxYear),
+		slog.Any("employee_ssn", f.EmployeeSSN),
+		slog.Any("employer_ein", f.EmployerEIN),
+	)
+}
+
+// LogValue implements slog.LogValuer. It logs identifying fields only, with
+// TINs masked, since a handler would otherwise encode the full form.
+func (f W9Form) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.Int("id", f.ID),
+		slog.String("external_id", f.ExternalID),
+		slog.String("entity_type", string(f.EntityType)),
+		slog.Any("ssn", f.SSN),
+		slog.Any("ein", f.EIN),
+	)
+}
+
+// LogValue implements slog.LogValuer. It logs identifying fields only, with
+// TINs masked, since a handler would otherwise encode the full form.
+func (f W8Form) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.Int("id", f.ID),
+		slog.String("external_id", f.ExternalID),
+		slog.String("form_type", f.FormType),
+		slog.Any("us_tin", f.USTIN),
+		slog.Any("foreign_tin", f.ForeignTIN),
+	)
+}
@@ -0,0 +1,92 @@
+package scheme
+
+import (
+	"fmt"
+	"log/slog"
+)
+
+// TIN is a taxpayer identification number, such as an SSN, an EIN or a
+// foreign TIN. It masks itself when formatted with the fmt package or logged
+// with log/slog, so that it never ends up in logs by accident; call Reveal for
+// the full value. JSON encoding keeps the full value.
+type TIN string
+
+// Reveal returns the unmasked TIN.
+func (t TIN) Reveal() string {
+	return string(t)
+}
+
+// Last4 returns the last four digits of the TIN.
+func (t TIN) Last4() string {
+	digits := make([]rune, 0, len(t))
+	for _, r := range t {
+		if r >= '0' && r <= '9' {
+			digits = append(digits, r)
+		}
+	}
+	if len(digits) < 4 {
+		return ""
+	}
+
+	return string(digits[len(digits)-4:])
+}
+
+// String implements fmt.Stringer and returns the masked TIN.
+func (t TIN) String() string {
+	return MaskTIN(string(t))
+}
+
+// GoString implements fmt.GoStringer and returns the masked TIN.
+func (t TIN) GoString() string {
+	return fmt.Sprintf("%q", MaskTIN(string(t)))
+}
+
+// Format implements fmt.Formatter so that every verb prints the masked TIN.
+func (t TIN) Format(f fmt.State, verb rune) {
+	switch verb {
+	case 'q':
+		fmt.Fprintf(f, "%q", MaskTIN(string(t)))
+	case 'v':
+		if f.Flag('#') {
+			fmt.Fprint(f, t.GoString())
+			return
+		}
+		fmt.Fprint(f, MaskTIN(string(t)))
+	default:
+		fmt.Fprint(f, MaskTIN(string(t)))
+	}
+}
+
+// LogValue implements slog.LogValuer and returns the masked TIN.
+func (t TIN) LogValue() slog.Value {
+	return slog.StringValue(MaskTIN(string(t)))
+}
+
+// MaskTIN masks every digit of tin but the last four, keeping separators, so
+// "123-45-6789" becomes "***-**-6789". A TIN with fewer than five digits is
+// masked entirely.
+func MaskTIN(tin string) string {
+	n := 0
+	for _, r := range tin {
+		if r >= '0' && r <= '9' {
+			n++
+		}
+	}
+
+	keep := 4
+	if n <= keep {
+		keep = 0
+	}
+	out := []rune(tin)
+	for i := range out {
+		if out[i] < '0' || out[i] > '9' {
+			continue
+		}
+		if n > keep {
+			out[i] = '*'
+		}
+		n--
+	}
+
+	return string(out)
+}
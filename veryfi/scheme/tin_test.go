@@ -0,0 +1,85 @@
+package scheme
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitMaskTIN(t *testing.T) {
+	tests := map[string]string{
+		"123-45-6789": "***-**-6789",
+		"12-3456789":  "**-***6789",
+		"123456789":   "*****6789",
+		"1234":        "****",
+		"":            "",
+		"DE 123 456":  "DE **3 456",
+	}
+	for in, expected := range tests {
+		assert.Equal(t, expected, MaskTIN(in), in)
+	}
+
+	assert.Equal(t, "6789", TIN("123-45-6789").Last4())
+	assert.Equal(t, "", TIN("12").Last4())
+}
+
+func TestUnitTIN_NeverFormatted(t *testing.T) {
+	form := W9Form{
+		TaxFormMeta: TaxFormMeta{ID: 1},
+		Name:        "Jane Doe",
+		EntityType:  W9EntityIndividual,
+		SSN:         "123-45-6789",
+	}
+
+	for _, format := range []string{"%v", "%+v", "%#v", "%s", "%q"} {
+		out := fmt.Sprintf(format, form.SSN)
+		assert.NotContains(t, out, "123-45", format)
+		assert.Contains(t, out, "6789", format)
+
+		out = fmt.Sprintf(format, form)
+		assert.NotContains(t, out, "123-45", format)
+	}
+	assert.Equal(t, "***-**-6789", form.SSN.String())
+	assert.Equal(t, "123-45-6789", form.SSN.Reveal())
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, nil))
+	logger.Info("received", "ssn", form.SSN, "form", form)
+	logger.Info("received", "w2", W2Form{EmployeeSSN: "123-45-6789", EmployerEIN: "12-3456789"})
+	logger.Info("received", "w8", &W8Form{USTIN: "123-45-6789"})
+	logger.Info("received", "w9", W9Form{TaxFormMeta: TaxFormMeta{OCRText: "SSN 123-45-6789"}})
+	assert.NotContains(t, buf.String(), "123-45")
+	assert.NotContains(t, buf.String(), "12-345")
+	assert.Contains(t, buf.String(), "***-**-6789")
+
+	// JSON keeps the full value so that forms can be stored and sent back.
+	data, err := json.Marshal(form)
+	assert.NoError(t, err)
+	assert.Contains(t, string(data), `"ssn":"123-45-6789"`)
+}
+
+func TestUnitTIN_NeverFormattedDecoded(t *testing.T) {
+	forms := map[string]any{"w2.json": &W2Form{}, "w9.json": &W9Form{}, "w8.json": &W8Form{}}
+	tins := []string{"123-45-6789", "12-3456789", "987-65-4321", "55-5555555", "98-7654321", "DE 123 456 789"}
+
+	for file, form := range forms {
+		data, err := os.ReadFile("../testdata/" + file)
+		assert.NoError(t, err)
+		assert.NoError(t, json.Unmarshal(data, form))
+
+		for _, format := range []string{"%v", "%+v", "%#v", "%s"} {
+			for _, v := range []any{form, reflect.ValueOf(form).Elem().Interface(), []any{form}} {
+				out := fmt.Sprintf(format, v)
+				for _, tin := range tins {
+					assert.NotContains(t, out, tin, file+" "+format)
+				}
+			}
+		}
+	}
+}
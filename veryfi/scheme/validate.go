@@ -0,0 +1,238 @@
+package scheme
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Severity ranks how likely a finding is to be an extraction error.
+type Severity int
+
+const (
+	// SeverityInfo is a difference within the rounding tolerance.
+	SeverityInfo Severity = iota
+
+	// SeverityWarning is a difference that has legitimate causes, such as
+	// line items that were only partly extracted.
+	SeverityWarning
+
+	// SeverityError is a difference that should not happen on a correctly
+	// extracted document.
+	SeverityError
+)
+
+// String returns the name of the severity, e.g. "warning".
+func (s Severity) String() string {
+	switch s {
+	case SeverityInfo:
+		return "info"
+	case SeverityWarning:
+		return "warning"
+	case SeverityError:
+		return "error"
+	default:
+		return fmt.Sprintf("Severity(%d)", int(s))
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s Severity) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+// ValidationCheck names an arithmetic check run by ValidateDocument.
+type ValidationCheck string
+
+const (
+	// TotalCheck checks that subtotal + tax + tip + shipping - discount +
+	// rounding is the total.
+	TotalCheck ValidationCheck = "total"
+
+	// SubtotalCheck checks that the line items add up to the subtotal.
+	SubtotalCheck ValidationCheck = "subtotal"
+
+	// TaxCheck checks that the tax lines add up to the tax.
+	TaxCheck ValidationCheck = "tax"
+
+	// TaxLineCheck checks that the total of a tax line is its rate applied to
+	// its base.
+	TaxLineCheck ValidationCheck = "tax_line"
+
+	// BalanceCheck checks that the previous balance plus the total is the
+	// balance, and that the final balance is the balance.
+	BalanceCheck ValidationCheck = "balance"
+)
+
+// Finding is an inconsistency found by ValidateDocument.
+type Finding struct {
+	Check    ValidationCheck `json:"check"`
+	Severity Severity        `json:"severity"`
+
+	// Field is the JSON path of the field that disagrees, e.g. "total" or
+	// "tax_lines[1].total".
+	Field string `json:"field"`
+
+	// Expected is the value computed from the other fields and Actual the
+	// value of Field.
+	Expected Amount `json:"expected"`
+	Actual   Amount `json:"actual"`
+
+	Message string `json:"message"`
+}
+
+// Findings is the result of ValidateDocument.
+type Findings []Finding
+
+// Max returns the highest severity of the findings, or -1 if there are none.
+func (f Findings) Max() Severity {
+	worst := Severity(-1)
+	for _, finding := range f {
+		worst = max(worst, finding.Severity)
+	}
+
+	return worst
+}
+
+// AtLeast returns the findings of severity min or higher.
+func (f Findings) AtLeast(min Severity) Findings {
+	var out Findings
+	for _, finding := range f {
+		if finding.Severity >= min {
+			out = append(out, finding)
+		}
+	}
+
+	return out
+}
+
+// ValidationOptions describes how ValidateDocument compares amounts.
+type ValidationOptions struct {
+	// Tolerance is the largest difference put down to rounding. Smaller
+	// differences are reported as SeverityInfo. It defaults to 0.01 when nil;
+	// point it to a zero Amount for exact checks.
+	Tolerance *Amount
+}
+
+// defaultTolerance is the tolerance of ValidateDocument when none is set.
+var defaultTolerance = NewAmount(1, 2)
+
+// ValidateDocument checks that the amounts of doc add up. Checks whose inputs
+// were not extracted, such as the tax lines of a receipt without any, are
+// skipped. Discounts are subtracted whatever their sign, since vendors print
+// them either way. An error is returned if doc is nil or has a value that
+// can not be checked, such as a NaN tax rate.
+func ValidateDocument(doc *Document, opts ValidationOptions) (Findings, error) {
+	if doc == nil {
+		return nil, errors.New("document can not be nil")
+	}
+
+	tolerance := defaultTolerance
+	if opts.Tolerance != nil {
+		tolerance = opts.Tolerance.Abs()
+	}
+
+	v := &validator{tolerance: tolerance}
+	v.total(doc)
+	v.subtotal(doc)
+	if err := v.tax(doc); err != nil {
+		return nil, err
+	}
+	v.balance(doc)
+
+	return v.findings, nil
+}
+
+// validator collects the findings of ValidateDocument.
+type validator struct {
+	tolerance Amount
+	findings  Findings
+}
+
+// compare records a finding if actual is not expected. Differences within
+// the tolerance are SeverityInfo, others have the given severity.
+func (v *validator) compare(check ValidationCheck, field string, expected, actual Amount, severity Severity, formula string) {
+	diff := actual.Sub(expected).Abs()
+	if diff.IsZero() {
+		return
+	}
+	if diff.Cmp(v.tolerance) <= 0 {
+		severity = SeverityInfo
+	}
+
+	v.findings = append(v.findings, Finding{
+		Check:    check,
+		Severity: severity,
+		Field:    field,
+		Expected: expected,
+		Actual:   actual,
+		Message:  fmt.Sprintf("%s is %s but %s is %s", formula, expected, field, actual),
+	})
+}
+
+// total checks the total against its components, if there is a subtotal.
+func (v *validator) total(doc *Document) {
+	if doc.Subtotal.IsZero() || doc.Total.IsZero() {
+		return
+	}
+
+	expected := SumAmounts(doc.Subtotal, doc.Tax, doc.Tip, doc.Shipping, doc.Discount.Abs().Neg(), doc.Rounding)
+	v.compare(TotalCheck, "total", expected, doc.Total, SeverityError, "subtotal + tax + tip + shipping - discount + rounding")
+}
+
+// subtotal checks the line items against the subtotal. Tax items are left
+// out, since they are part of the tax. A mismatch is only a warning, as long
+// documents often have line items that were not extracted.
+func (v *validator) subtotal(doc *Document) {
+	if len(doc.LineItems) == 0 || doc.Subtotal.IsZero() {
+		return
+	}
+
+	var sum Amount
+	for _, item := range doc.LineItems {
+		if !strings.EqualFold(item.Type, "tax") {
+			sum = sum.Add(item.Total)
+		}
+	}
+	v.compare(SubtotalCheck, "subtotal", sum, doc.Subtotal, SeverityWarning, "the sum of line_items")
+}
+
+// tax checks the tax lines against the tax, and each against its rate. Tax
+// line totals are expected to be rounded to the minor unit of the currency.
+func (v *validator) tax(doc *Document) error {
+	if len(doc.TaxLines) == 0 {
+		return nil
+	}
+
+	digits := CurrencyDigits(doc.CurrencyCode)
+	var sum Amount
+	for i, line := range doc.TaxLines {
+		sum = sum.Add(line.Total)
+		rate, err := AmountFromFloat(line.Rate)
+		if err != nil {
+			return errors.Wrapf(err, "invalid rate of tax_lines[%d]", i)
+		}
+		if !rate.IsZero() && !line.Base.IsZero() {
+			expected := line.Base.Mul(rate).Div(AmountFromInt(100), digits)
+			field := fmt.Sprintf("tax_lines[%d].total", i)
+			v.compare(TaxLineCheck, field, expected, line.Total, SeverityWarning, fmt.Sprintf("%s%% of %s", rate, line.Base))
+		}
+	}
+	v.compare(TaxCheck, "tax", sum, doc.Tax, SeverityError, "the sum of tax_lines")
+
+	return nil
+}
+
+// balance checks that the balances chain, if a previous or final balance was
+// extracted. Payments can legitimately break the chain, so mismatches are
+// warnings.
+func (v *validator) balance(doc *Document) {
+	if !doc.PreviousBalance.IsZero() && !doc.Balance.IsZero() {
+		expected := doc.PreviousBalance.Add(doc.Total)
+		v.compare(BalanceCheck, "balance", expected, doc.Balance, SeverityWarning, "previous_balance + total")
+	}
+	if !doc.FinalBalance.IsZero() && !doc.Balance.IsZero() {
+		v.compare(BalanceCheck, "final_balance", doc.Balance, doc.FinalBalance, SeverityWarning, "balance")
+	}
+}
@@ -0,0 +1,150 @@
+package scheme
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// consistentDocument returns a document whose amounts all add up.
+func consistentDocument() *Document {
+	return &Document{
+		CurrencyCode: "USD",
+		LineItems: []LineItem{
+			{Type: "product", Total: MustParseAmount("9.98")},
+			{Type: "product", Total: MustParseAmount("10")},
+			{Type: "tax", Total: MustParseAmount("2")},
+		},
+		TaxLines: []TaxLine{{Rate: 10, Base: MustParseAmount("19.98"), Total: MustParseAmount("2")}},
+		Subtotal: MustParseAmount("19.98"),
+		Tax:      MustParseAmount("2"),
+		Tip:      MustParseAmount("3"),
+		Shipping: MustParseAmount("5"),
+		Discount: MustParseAmount("-1.5"),
+		Rounding: MustParseAmount("0.02"),
+		Total:    MustParseAmount("28.5"),
+	}
+}
+
+func TestUnitValidateDocument_Consistent(t *testing.T) {
+	findings, err := ValidateDocument(consistentDocument(), ValidationOptions{})
+	assert.NoError(t, err)
+	assert.Empty(t, findings)
+	assert.Equal(t, Severity(-1), findings.Max())
+
+	// Documents without the inputs of a check skip it.
+	findings, err = ValidateDocument(&Document{Total: MustParseAmount("12.5")}, ValidationOptions{})
+	assert.NoError(t, err)
+	assert.Empty(t, findings)
+}
+
+func TestUnitValidateDocument_Findings(t *testing.T) {
+	doc := consistentDocument()
+	doc.Total = MustParseAmount("28.51")
+	doc.Subtotal = MustParseAmount("29.98")
+	doc.TaxLines = append(doc.TaxLines, TaxLine{Rate: 5, Base: MustParseAmount("10"), Total: MustParseAmount("0.7")})
+
+	findings, err := ValidateDocument(doc, ValidationOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, Findings{
+		{
+			Check:    TotalCheck,
+			Severity: SeverityError,
+			Field:    "total",
+			Expected: MustParseAmount("38.5"),
+			Actual:   MustParseAmount("28.51"),
+			Message:  "subtotal + tax + tip + shipping - discount + rounding is 38.5 but total is 28.51",
+		},
+		{
+			Check:    SubtotalCheck,
+			Severity: SeverityWarning,
+			Field:    "subtotal",
+			Expected: MustParseAmount("19.98"),
+			Actual:   MustParseAmount("29.98"),
+			Message:  "the sum of line_items is 19.98 but subtotal is 29.98",
+		},
+		{
+			Check:    TaxLineCheck,
+			Severity: SeverityWarning,
+			Field:    "tax_lines[1].total",
+			Expected: MustParseAmount("0.5"),
+			Actual:   MustParseAmount("0.7"),
+			Message:  "5% of 10 is 0.5 but tax_lines[1].total is 0.7",
+		},
+		{
+			Check:    TaxCheck,
+			Severity: SeverityError,
+			Field:    "tax",
+			Expected: MustParseAmount("2.7"),
+			Actual:   MustParseAmount("2"),
+			Message:  "the sum of tax_lines is 2.7 but tax is 2",
+		},
+	}, findings)
+	assert.Equal(t, SeverityError, findings.Max())
+	assert.Len(t, findings.AtLeast(SeverityError), 2)
+
+	out, err := json.Marshal(findings[0])
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"check": "total", "severity": "error", "field": "total", "expected": 38.5, "actual": 28.51,
+		"message": "subtotal + tax + tip + shipping - discount + rounding is 38.5 but total is 28.51"}`, string(out))
+}
+
+func TestUnitValidateDocument_Tolerance(t *testing.T) {
+	doc := consistentDocument()
+	doc.Total = MustParseAmount("28.51")
+
+	findings, err := ValidateDocument(doc, ValidationOptions{})
+	assert.NoError(t, err)
+	assert.Len(t, findings, 1)
+	assert.Equal(t, SeverityInfo, findings[0].Severity)
+	assert.Empty(t, findings.AtLeast(SeverityWarning))
+
+	tolerance := MustParseAmount("0.001")
+	findings, err = ValidateDocument(doc, ValidationOptions{Tolerance: &tolerance})
+	assert.NoError(t, err)
+	assert.Equal(t, SeverityError, findings.Max())
+
+	// A zero tolerance is kept, so that amounts must match exactly.
+	exact := Amount{}
+	doc.Total = MustParseAmount("28.50")
+	findings, err = ValidateDocument(doc, ValidationOptions{Tolerance: &exact})
+	assert.NoError(t, err)
+	assert.Empty(t, findings)
+	doc.Total = MustParseAmount("28.505")
+	findings, err = ValidateDocument(doc, ValidationOptions{Tolerance: &exact})
+	assert.NoError(t, err)
+	assert.Equal(t, SeverityError, findings.Max())
+}
+
+func TestUnitValidateDocument_Balance(t *testing.T) {
+	doc := &Document{
+		Total:           MustParseAmount("100"),
+		PreviousBalance: MustParseAmount("50"),
+		Balance:         MustParseAmount("150"),
+		FinalBalance:    MustParseAmount("150"),
+	}
+	findings, err := ValidateDocument(doc, ValidationOptions{})
+	assert.NoError(t, err)
+	assert.Empty(t, findings)
+
+	doc.Balance = MustParseAmount("120")
+	findings, err = ValidateDocument(doc, ValidationOptions{})
+	assert.NoError(t, err)
+	assert.Len(t, findings, 2)
+	assert.Equal(t, "balance", findings[0].Field)
+	assert.Equal(t, "final_balance", findings[1].Field)
+	assert.Equal(t, BalanceCheck, findings[1].Check)
+	assert.Equal(t, SeverityWarning, findings.Max())
+}
+
+func TestUnitValidateDocument_Invalid(t *testing.T) {
+	doc := consistentDocument()
+	doc.TaxLines[0].Rate = math.NaN()
+	_, err := ValidateDocument(doc, ValidationOptions{})
+	assert.EqualError(t, err, "invalid rate of tax_lines[0]: invalid amount NaN")
+
+	_, err = ValidateDocument(nil, ValidationOptions{})
+	assert.EqualError(t, err, "document can not be nil")
+}
@@ -0,0 +1,195 @@
+package scheme
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"unicode/utf8"
+)
+
+// vCardLineLength is the maximum length of a vCard content line in octets,
+// excluding the line break.
+const vCardLineLength = 75
+
+// VCard returns the business card as a vCard 4.0 (RFC 6350).
+func (b *BusinessCard) VCard() string {
+	buf := &bytes.Buffer{}
+	_ = b.WriteVCard(buf)
+	return buf.String()
+}
+
+// WriteVCard writes the business card to w as a vCard 4.0 (RFC 6350). Social
+// profiles are written as SOCIALPROFILE properties (RFC 9554).
+func (b *BusinessCard) WriteVCard(w io.Writer) error {
+	lines := []string{"BEGIN:VCARD", "VERSION:4.0"}
+	add := func(name, value string) {
+		lines = append(lines, name+":"+value)
+	}
+
+	add("FN", vCardText(b.formattedName()))
+	if b.LastName != "" || b.FirstName != "" || b.MiddleName != "" || b.Prefix != "" || b.Suffix != "" {
+		add("N", strings.Join([]string{
+			vCardText(b.LastName),
+			vCardText(b.FirstName),
+			vCardText(b.MiddleName),
+			vCardText(b.Prefix),
+			vCardText(b.Suffix),
+		}, ";"))
+	}
+	for i, company := range b.CompanyNames {
+		org := vCardText(company)
+		if i < len(b.Departments) && b.Departments[i] != "" {
+			org += ";" + vCardText(b.Departments[i])
+		}
+		add("ORG", org)
+	}
+	for _, title := range b.JobTitles {
+		add("TITLE", vCardText(title))
+	}
+	for _, phone := range b.Phones {
+		name := "TEL;VALUE=text"
+		if t := vCardPhoneType(phone.Type); t != "" {
+			name += ";TYPE=" + t
+		}
+		add(name, vCardText(phone.Number))
+	}
+	for _, email := range b.Emails {
+		add("EMAIL", vCardText(email))
+	}
+	for _, addr := range b.Addresses {
+		name := "ADR"
+		if addr.Type == "work" || addr.Type == "home" {
+			name += ";TYPE=" + addr.Type
+		}
+		if addr.Address != "" {
+			name += ";LABEL=" + vCardParam(addr.Address)
+		}
+		add(name, vCardAddress(addr))
+	}
+	for _, website := range b.Websites {
+		add("URL", website)
+	}
+	for _, social := range b.SocialHandles {
+		name := "SOCIALPROFILE"
+		if social.Network != "" {
+			name += ";SERVICE-TYPE=" + vCardParam(social.Network)
+		}
+		if social.URL != "" {
+			add(name, social.URL)
+		} else {
+			add(name+";VALUE=text", vCardText(social.Handle))
+		}
+	}
+	lines = append(lines, "END:VCARD")
+
+	for _, line := range lines {
+		if _, err := io.WriteString(w, foldVCardLine(line)+"\r\n"); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// formattedName returns the name to use for the required FN property.
+func (b *BusinessCard) formattedName() string {
+	if b.FullName != "" {
+		return b.FullName
+	}
+	parts := []string{}
+	for _, p := range []string{b.Prefix, b.FirstName, b.MiddleName, b.LastName, b.Suffix} {
+		if p != "" {
+			parts = append(parts, p)
+		}
+	}
+	if len(parts) > 0 {
+		return strings.Join(parts, " ")
+	}
+	if len(b.CompanyNames) > 0 {
+		return b.CompanyNames[0]
+	}
+
+	return ""
+}
+
+// vCardPhoneType maps a ContactPhone type to a vCard TEL type.
+func vCardPhoneType(t string) string {
+	switch t {
+	case "work", "home", "fax":
+		return t
+	case "mobile":
+		return "cell"
+	default:
+		return ""
+	}
+}
+
+// vCardAddress returns the structured ADR value of an address. When the
+// address was not parsed, it is kept whole as the street address.
+func vCardAddress(addr ContactAddress) string {
+	p := addr.ParsedAddress
+	street := p.StreetAddress
+	if street == "" {
+		street = strings.TrimSpace(strings.Join([]string{p.HouseNumber, p.Road}, " "))
+	}
+	if street == "" && p.City == "" && p.Postcode == "" {
+		street = addr.Address
+	}
+
+	return strings.Join([]string{
+		vCardText(p.POBox),
+		vCardText(p.Unit),
+		vCardText(street),
+		vCardText(p.City),
+		vCardText(p.State),
+		vCardText(p.Postcode),
+		vCardText(p.Country),
+	}, ";")
+}
+
+// vCardText escapes a text value.
+func vCardText(s string) string {
+	return strings.NewReplacer(
+		`\`, `\\`,
+		",", `\,`,
+		";", `\;`,
+		"\r\n", `\n`,
+		"\n", `\n`,
+	).Replace(s)
+}
+
+// vCardParam quotes a parameter value, escaping it as described in RFC 6868.
+func vCardParam(s string) string {
+	return `"` + strings.NewReplacer(
+		"^", "^^",
+		"\r\n", "^n",
+		"\n", "^n",
+		`"`, "^'",
+	).Replace(s) + `"`
+}
+
+// foldVCardLine folds a content line longer than vCardLineLength octets,
+// without splitting a UTF-8 sequence.
+func foldVCardLine(line string) string {
+	if len(line) <= vCardLineLength {
+		return line
+	}
+
+	var sb strings.Builder
+	limit := vCardLineLength
+	n := 0
+	for len(line) > 0 {
+		_, size := utf8.DecodeRuneInString(line)
+		if n+size > limit {
+			sb.WriteString("\r\n ")
+			// The leading space counts towards the length of the next line.
+			limit = vCardLineLength - 1
+			n = 0
+		}
+		sb.WriteString(line[:size])
+		n += size
+		line = line[size:]
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,89 @@
+package scheme
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitBusinessCard_VCard(t *testing.T) {
+	card := &BusinessCard{
+		FullName:     "Dr. Jane Q. Doe, PhD",
+		Prefix:       "Dr.",
+		FirstName:    "Jane",
+		MiddleName:   "Q.",
+		LastName:     "Doe",
+		Suffix:       "PhD",
+		JobTitles:    []string{"VP, Engineering"},
+		Departments:  []string{"R&D"},
+		CompanyNames: []string{"Acme; Inc."},
+		Phones: []ContactPhone{
+			{Number: "+1 (555) 010-0100", Type: "work"},
+			{Number: "+1 555 010 0199", Type: "mobile"},
+			{Number: "555-0142"},
+		},
+		Emails: []string{"jane@acme.example"},
+		Addresses: []ContactAddress{
+			{
+				Address: "1 Main St\nSpringfield, IL 62701",
+				Type:    "work",
+				ParsedAddress: ParsedAddress{
+					StreetAddress: "1 Main St",
+					City:          "Springfield",
+					State:         "IL",
+					Postcode:      "62701",
+					Country:       "USA",
+				},
+			},
+			{Address: "PO Box 9, Shelbyville"},
+		},
+		Websites: []string{"https://acme.example"},
+		SocialHandles: []SocialHandle{
+			{Network: "LinkedIn", URL: "https://www.linkedin.com/in/janedoe"},
+			{Network: "X", Handle: "@janedoe"},
+		},
+	}
+
+	expected := strings.Join([]string{
+		"BEGIN:VCARD",
+		"VERSION:4.0",
+		`FN:Dr. Jane Q. Doe\, PhD`,
+		"N:Doe;Jane;Q.;Dr.;PhD",
+		`ORG:Acme\; Inc.;R&D`,
+		`TITLE:VP\, Engineering`,
+		"TEL;VALUE=text;TYPE=work:+1 (555) 010-0100",
+		"TEL;VALUE=text;TYPE=cell:+1 555 010 0199",
+		"TEL;VALUE=text:555-0142",
+		"EMAIL:jane@acme.example",
+		`ADR;TYPE=work;LABEL="1 Main St^nSpringfield, IL 62701":;;1 Main St;Springfi`,
+		" eld;IL;62701;USA",
+		`ADR;LABEL="PO Box 9, Shelbyville":;;PO Box 9\, Shelbyville;;;;`,
+		"URL:https://acme.example",
+		`SOCIALPROFILE;SERVICE-TYPE="LinkedIn":https://www.linkedin.com/in/janedoe`,
+		`SOCIALPROFILE;SERVICE-TYPE="X";VALUE=text:@janedoe`,
+		"END:VCARD",
+		"",
+	}, "\r\n")
+	assert.Equal(t, expected, card.VCard())
+}
+
+func TestUnitBusinessCard_VCardFormattedName(t *testing.T) {
+	card := &BusinessCard{FirstName: "Jane", LastName: "Doe"}
+	assert.Contains(t, card.VCard(), "\r\nFN:Jane Doe\r\n")
+
+	card = &BusinessCard{CompanyNames: []string{"Acme"}}
+	vcard := card.VCard()
+	assert.Contains(t, vcard, "\r\nFN:Acme\r\n")
+	assert.NotContains(t, vcard, "\r\nN:")
+}
+
+func TestUnitFoldVCardLine(t *testing.T) {
+	line := "NOTE:" + strings.Repeat("é", 80)
+	folded := foldVCardLine(line)
+	for _, l := range strings.Split(folded, "\r\n") {
+		assert.LessOrEqual(t, len(l), vCardLineLength)
+		assert.True(t, strings.HasPrefix(l, "NOTE:") || strings.HasPrefix(l, " "))
+	}
+	assert.Equal(t, line, strings.ReplaceAll(folded, "\r\n ", ""))
+}
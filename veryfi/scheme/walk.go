@@ -0,0 +1,118 @@
+package scheme
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// ScoredField is a field of a detailed response with its confidence.
+type ScoredField struct {
+	// Path is the JSON path of the field, e.g. "vendor.name" or
+	// "line_items_with_scores[2].total".
+	Path string `json:"path"`
+
+	// Value is the string, float64 or bool value of the field, or nil if the
+	// field has scores but no value.
+	Value any `json:"value"`
+
+	Score          *float64  `json:"score,omitempty"`
+	OCRScore       *float64  `json:"ocr_score,omitempty"`
+	BoundingBox    []float64 `json:"bounding_box,omitempty"`
+	BoundingRegion []float64 `json:"bounding_region,omitempty"`
+
+	// Enriched is true if the value was inferred rather than read from the
+	// document.
+	Enriched bool `json:"enriched"`
+}
+
+// DetailedObject is a detailed response type that WalkDetailed can walk.
+type DetailedObject interface {
+	DetailedDocument | DetailedVendor | DetailedToField | DetailedPayment | DetailedLineItem | DetailedTaxLine
+}
+
+// scoredField is implemented by the field types carrying confidence scores.
+type scoredField interface {
+	scored() ScoredField
+}
+
+// WalkDetailed calls fn for every field of v that has confidence scores, in
+// declaration order, descending into nested objects, line items and tax
+// lines. Absent fields are skipped. Walking stops when fn returns false.
+func WalkDetailed[T DetailedObject](v *T, fn func(ScoredField) bool) {
+	if v == nil {
+		return
+	}
+
+	walk(reflect.ValueOf(v).Elem(), "", fn)
+}
+
+// DetailedFields returns every field of v that has confidence scores, as
+// visited by WalkDetailed.
+func DetailedFields[T DetailedObject](v *T) []ScoredField {
+	var fields []ScoredField
+	WalkDetailed(v, func(f ScoredField) bool {
+		fields = append(fields, f)
+		return true
+	})
+
+	return fields
+}
+
+// walk visits v, whose JSON path is path, and reports whether to go on.
+func walk(v reflect.Value, path string, fn func(ScoredField) bool) bool {
+	switch v.Kind() {
+	case reflect.Pointer:
+		if v.IsNil() {
+			return true
+		}
+		if f, ok := v.Interface().(scoredField); ok {
+			field := f.scored()
+			field.Path = path
+			return fn(field)
+		}
+		return walk(v.Elem(), path, fn)
+
+	case reflect.Struct:
+		t := v.Type()
+		for i := 0; i < t.NumField(); i++ {
+			name := jsonName(t.Field(i))
+			if name == "" {
+				continue
+			}
+			if path != "" {
+				name = path + "." + name
+			}
+			if !walk(v.Field(i), name, fn) {
+				return false
+			}
+		}
+
+	case reflect.Slice:
+		for i := 0; i < v.Len(); i++ {
+			if !walk(v.Index(i), path+"["+strconv.Itoa(i)+"]", fn) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// jsonName returns the JSON name of an exported struct field, or "" if it is
+// not encoded.
+func jsonName(f reflect.StructField) string {
+	if !f.IsExported() {
+		return ""
+	}
+
+	name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+	switch name {
+	case "-":
+		return ""
+	case "":
+		return f.Name
+	default:
+		return name
+	}
+}
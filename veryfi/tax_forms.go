@@ -0,0 +1,172 @@
+package veryfi
+
+import (
+	"fmt"
+
+	"github.com/veryfi/veryfi-go/v4/veryfi/scheme"
+)
+
+// processTaxFormUpload uploads a tax form to uri and decodes the response into out.
+func (c *Client) processTaxFormUpload(uri string, opts scheme.TaxFormUploadOptions, out interface{}) error {
+	encodedFile, err := Base64EncodeFile(opts.FilePath)
+	if err != nil {
+		return err
+	}
+
+	payload := scheme.TaxFormUploadBase64Options{
+		FileData:             encodedFile,
+		TaxFormSharedOptions: opts.TaxFormSharedOptions,
+	}
+
+	return c.post(uri, payload, out)
+}
+
+// ProcessW2Upload returns the processed W-2 form.
+func (c *Client) ProcessW2Upload(opts scheme.TaxFormUploadOptions) (*scheme.W2Form, error) {
+	out := new(*scheme.W2Form)
+	if err := c.processTaxFormUpload(w2URI, opts, out); err != nil {
+		return nil, err
+	}
+
+	return *out, nil
+}
+
+// ProcessW2URL returns the processed W-2 form using URL.
+func (c *Client) ProcessW2URL(opts scheme.TaxFormURLOptions) (*scheme.W2Form, error) {
+	out := new(*scheme.W2Form)
+	if err := c.post(w2URI, opts, out); err != nil {
+		return nil, err
+	}
+
+	return *out, nil
+}
+
+// GetW2 returns a processed W-2 form.
+func (c *Client) GetW2(documentID string) (*scheme.W2Form, error) {
+	out := new(*scheme.W2Form)
+	if err := c.get(fmt.Sprintf("%s%s", w2URI, documentID), nil, out); err != nil {
+		return nil, err
+	}
+
+	return *out, nil
+}
+
+// SearchW2s returns a list of processed W-2 forms with matching queries.
+func (c *Client) SearchW2s(opts scheme.TaxFormSearchOptions) (*scheme.W2Forms, error) {
+	out := new(*scheme.W2Forms)
+	if err := c.get(w2URI, opts, out); err != nil {
+		return nil, err
+	}
+
+	return *out, nil
+}
+
+// DeleteW2 deletes a processed W-2 form.
+func (c *Client) DeleteW2(documentID string) error {
+	err := c.rdelete(fmt.Sprintf("%s%s", w2URI, documentID))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ProcessW9Upload returns the processed W-9 form.
+func (c *Client) ProcessW9Upload(opts scheme.TaxFormUploadOptions) (*scheme.W9Form, error) {
+	out := new(*scheme.W9Form)
+	if err := c.processTaxFormUpload(w9URI, opts, out); err != nil {
+		return nil, err
+	}
+
+	return *out, nil
+}
+
+// ProcessW9URL returns the processed W-9 form using URL.
+func (c *Client) ProcessW9URL(opts scheme.TaxFormURLOptions) (*scheme.W9Form, error) {
+	out := new(*scheme.W9Form)
+	if err := c.post(w9URI, opts, out); err != nil {
+		return nil, err
+	}
+
+	return *out, nil
+}
+
+// GetW9 returns a processed W-9 form.
+func (c *Client) GetW9(documentID string) (*scheme.W9Form, error) {
+	out := new(*scheme.W9Form)
+	if err := c.get(fmt.Sprintf("%s%s", w9URI, documentID), nil, out); err != nil {
+		return nil, err
+	}
+
+	return *out, nil
+}
+
+// SearchW9s returns a list of processed W-9 forms with matching queries.
+func (c *Client) SearchW9s(opts scheme.TaxFormSearchOptions) (*scheme.W9Forms, error) {
+	out := new(*scheme.W9Forms)
+	if err := c.get(w9URI, opts, out); err != nil {
+		return nil, err
+	}
+
+	return *out, nil
+}
+
+// DeleteW9 deletes a processed W-9 form.
+func (c *Client) DeleteW9(documentID string) error {
+	err := c.rdelete(fmt.Sprintf("%s%s", w9URI, documentID))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ProcessW8Upload returns the processed W-8 form.
+func (c *Client) ProcessW8Upload(opts scheme.TaxFormUploadOptions) (*scheme.W8Form, error) {
+	out := new(*scheme.W8Form)
+	if err := c.processTaxFormUpload(w8URI, opts, out); err != nil {
+		return nil, err
+	}
+
+	return *out, nil
+}
+
+// ProcessW8URL returns the processed W-8 form using URL.
+func (c *Client) ProcessW8URL(opts scheme.TaxFormURLOptions) (*scheme.W8Form, error) {
+	out := new(*scheme.W8Form)
+	if err := c.post(w8URI, opts, out); err != nil {
+		return nil, err
+	}
+
+	return *out, nil
+}
+
+// GetW8 returns a processed W-8 form.
+func (c *Client) GetW8(documentID string) (*scheme.W8Form, error) {
+	out := new(*scheme.W8Form)
+	if err := c.get(fmt.Sprintf("%s%s", w8URI, documentID), nil, out); err != nil {
+		return nil, err
+	}
+
+	return *out, nil
+}
+
+// SearchW8s returns a list of processed W-8 forms with matching queries.
+func (c *Client) SearchW8s(opts scheme.TaxFormSearchOptions) (*scheme.W8Forms, error) {
+	out := new(*scheme.W8Forms)
+	if err := c.get(w8URI, opts, out); err != nil {
+		return nil, err
+	}
+
+	return *out, nil
+}
+
+// DeleteW8 deletes a processed W-8 form.
+func (c *Client) DeleteW8(documentID string) error {
+	err := c.rdelete(fmt.Sprintf("%s%s", w8URI, documentID))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,60 @@
+package veryfi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/veryfi/veryfi-go/v4/veryfi/scheme"
+)
+
+func TestUnitClientV8_TaxForms(t *testing.T) {
+	server, client, body := setUpEndpoints(t,
+		endpoint{uri: "w2s", id: "1", file: "w2.json"},
+		endpoint{uri: "w9s", id: "1", file: "w9.json"},
+		endpoint{uri: "w-8ben-e", id: "1", file: "w8.json"},
+	)
+	defer server.Close()
+
+	w2, err := client.ProcessW2Upload(scheme.TaxFormUploadOptions{
+		FilePath: "testdata/receipt_public.jpg",
+		TaxFormSharedOptions: scheme.TaxFormSharedOptions{
+			ExternalID: "employee-7",
+		},
+	})
+	assert.NoError(t, err)
+	assert.NotEmpty(t, body["file_data"])
+	assert.Equal(t, "employee-7", body["external_id"])
+	assert.Equal(t, "123-45-6789", w2.EmployeeSSN.Reveal())
+	assert.Equal(t, "12-3456789", w2.EmployerEIN.Reveal())
+	assert.Equal(t, scheme.MustParseAmount("85000"), w2.Box1WagesTipsOtherCompensation)
+	assert.Equal(t, scheme.MustParseAmount("1232.5"), w2.Box6MedicareTaxWithheld)
+	assert.Equal(t, []scheme.W2Box12{{Code: "D", Amount: scheme.MustParseAmount("6000")}}, w2.Box12)
+	assert.True(t, w2.Box13RetirementPlan)
+	assert.Equal(t, scheme.MustParseAmount("4200"), w2.States[0].Box17StateIncomeTax)
+
+	w9, err := client.ProcessW9URL(scheme.TaxFormURLOptions{FileURL: "https://example.com/w9.pdf"})
+	assert.NoError(t, err)
+	assert.Equal(t, "https://example.com/w9.pdf", body["file_url"])
+	assert.Equal(t, scheme.W9EntityLLC, w9.EntityType)
+	assert.Equal(t, "**-***4321", w9.EIN.String())
+	assert.Equal(t, "2024-01-15", w9.CertificationDate)
+
+	w8, err := client.GetW8("1")
+	assert.NoError(t, err)
+	assert.Equal(t, "W-8BEN-E", w8.FormType)
+	assert.Equal(t, "DE 123 456 789", w8.ForeignTIN.Reveal())
+
+	w2s, err := client.SearchW2s(scheme.TaxFormSearchOptions{})
+	assert.NoError(t, err)
+	assert.Len(t, w2s.W2s, 1)
+	w9s, err := client.SearchW9s(scheme.TaxFormSearchOptions{})
+	assert.NoError(t, err)
+	assert.Len(t, w9s.W9s, 1)
+	w8s, err := client.SearchW8s(scheme.TaxFormSearchOptions{})
+	assert.NoError(t, err)
+	assert.Len(t, w8s.W8s, 1)
+
+	assert.NoError(t, client.DeleteW2("1"))
+	assert.NoError(t, client.DeleteW9("1"))
+	assert.NoError(t, client.DeleteW8("1"))
+}
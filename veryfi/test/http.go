@@ -37,6 +37,11 @@ func (s HTTPServer) Serve(t *testing.T, uri string, statusCode int, response int
 	serve(s.mux, t, uri, statusCode, response)
 }
 
+// Handle registers a custom handler for uri, e.g. to inspect requests.
+func (s HTTPServer) Handle(uri string, handler http.HandlerFunc) {
+	s.mux.HandleFunc(uri, handler)
+}
+
 // Close closes the server connection.
 func (s HTTPServer) Close() {
 	s.server.Close()
@@ -12,4 +12,31 @@ const (
 
 	// globalTagURI is the URI for the `/partner/tags/` route.
 	globalTagURI = "/partner/tags/"
+
+	// anyDocumentURI is the URI for the `/partner/any-documents/` route.
+	anyDocumentURI = "/partner/any-documents/"
+
+	// bankStatementURI is the URI for the `/partner/bank-statements/` route.
+	bankStatementURI = "/partner/bank-statements/"
+
+	// checkURI is the URI for the `/partner/checks/` route.
+	checkURI = "/partner/checks/"
+
+	// w2URI is the URI for the `/partner/w2s/` route.
+	w2URI = "/partner/w2s/"
+
+	// w9URI is the URI for the `/partner/w9s/` route.
+	w9URI = "/partner/w9s/"
+
+	// w8URI is the URI for the `/partner/w-8ben-e/` route.
+	w8URI = "/partner/w-8ben-e/"
+
+	// businessCardURI is the URI for the `/partner/business-cards/` route.
+	businessCardURI = "/partner/business-cards/"
+
+	// classifyURI is the URI for the `/partner/classify/` route.
+	classifyURI = "/partner/classify/"
+
+	// documentBatchURI is the URI for the `/partner/documents-set/` route.
+	documentBatchURI = "/partner/documents-set/"
 )